@@ -8,49 +8,9 @@ import (
 	"strings"
 	"testing"
 
-	v1 "dhivehi-translit/internal/translit1"
-	v2 "dhivehi-translit/internal/translit2"
-	v3 "dhivehi-translit/internal/translit3"
-	v4 "dhivehi-translit/internal/translit4"
+	"dhivehi-translit/translit"
 )
 
-// levenshtein returns the character-level edit distance between a and b.
-func levenshtein(a, b string) int {
-	ar, br := []rune(a), []rune(b)
-	na, nb := len(ar), len(br)
-	if na == 0 {
-		return nb
-	}
-	if nb == 0 {
-		return na
-	}
-	// One row of the DP table (we only need previous row).
-	prev := make([]int, nb+1)
-	curr := make([]int, nb+1)
-	for j := 0; j <= nb; j++ {
-		prev[j] = j
-	}
-	for i := 1; i <= na; i++ {
-		curr[0] = i
-		for j := 1; j <= nb; j++ {
-			cost := 1
-			if ar[i-1] == br[j-1] {
-				cost = 0
-			}
-			curr[j] = min(curr[j-1]+1, min(prev[j]+1, prev[j-1]+cost))
-		}
-		prev, curr = curr, prev
-	}
-	return prev[nb]
-}
-
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
 // loadGoldenCases reads testdata/golden_cases.txt (tab-separated input, expected).
 func loadGoldenCases(t *testing.T) (inputs []string, expected []string) {
 	dir, _ := os.Getwd()
@@ -96,7 +56,7 @@ type Stats struct {
 	AvgEditDist     float64 `json:"avg_character_edit_distance"`
 }
 
-// TestWriteAccuracyReport runs all four engines on the golden dataset and writes
+// TestWriteAccuracyReport runs the engines of benchmarkedIDs on the golden dataset and writes
 // accuracy_report.json to the project root.
 func TestWriteAccuracyReport(t *testing.T) {
 	inputs, expected := loadGoldenCases(t)
@@ -104,32 +64,23 @@ func TestWriteAccuracyReport(t *testing.T) {
 		return
 	}
 	n := len(inputs)
-	engines := []struct {
-		name string
-		fn   func(string) string
-	}{
-		{"translit1", v1.Transliterate},
-		{"translit2", v2.Transliterate},
-		{"translit3", v3.Transliterate},
-		{"translit4", v4.Transliterate},
-	}
 	report := AccuracyReport{
 		TotalCases: n,
 		Versions:   make(map[string]Stats),
 	}
-	for _, e := range engines {
+	for _, e := range registeredEngines() {
 		exact := 0
 		totalDist := 0
 		for i := 0; i < n; i++ {
-			out := e.fn(inputs[i])
+			out := e.Transliterate(inputs[i])
 			if out == expected[i] {
 				exact++
 			}
-			totalDist += levenshtein(out, expected[i])
+			totalDist += translit.Distance(out, expected[i])
 		}
 		pct := 100.0 * float64(exact) / float64(n)
 		avgDist := float64(totalDist) / float64(n)
-		report.Versions[e.name] = Stats{
+		report.Versions[e.Name()] = Stats{
 			ExactMatchPct: pct,
 			ExactMatches:  exact,
 			TotalEditDist: totalDist,
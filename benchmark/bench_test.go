@@ -7,10 +7,7 @@ import (
 	"strings"
 	"testing"
 
-	v1 "dhivehi-translit/internal/translit1"
-	v2 "dhivehi-translit/internal/translit2"
-	v3 "dhivehi-translit/internal/translit3"
-	v4 "dhivehi-translit/internal/translit4"
+	"dhivehi-translit/translit"
 )
 
 // Short input: a single common word.
@@ -55,59 +52,71 @@ func dataset10k() string {
 	return strings.Join(words[:targetWords], " ")
 }
 
-// --- Unified 10k-word benchmarks (same dataset for all versions) ---
-
-func BenchmarkTranslit1(b *testing.B) {
-	input := dataset10k()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v1.Transliterate(input)
+// benchmarkedIDs are the engines benchmarked and scored against
+// testdata/golden_cases.txt. Profiles such as ALA-LC or the southern dialect
+// follow other conventions and are left out, so reports keep a stable
+// translit1…translit4 column order.
+var benchmarkedIDs = []string{
+	translit.IDOriginal,
+	translit.IDMap,
+	translit.IDQawaaidu,
+	translit.IDFast,
+}
+
+// registeredEngines returns the engines of benchmarkedIDs from the translit
+// registry.
+func registeredEngines() []translit.Engine {
+	engines := make([]translit.Engine, 0, len(benchmarkedIDs))
+	for _, id := range benchmarkedIDs {
+		e, err := translit.Lookup(id)
+		if err != nil {
+			panic(err)
+		}
+		engines = append(engines, e)
 	}
+	return engines
 }
 
-func BenchmarkTranslit2(b *testing.B) {
-	input := dataset10k()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v2.Transliterate(input)
+// engine returns the registered engine with the given ID.
+func engine(b *testing.B, id string) translit.Engine {
+	e, err := translit.Lookup(id)
+	if err != nil {
+		b.Fatal(err)
 	}
+	return e
 }
 
-func BenchmarkTranslit3(b *testing.B) {
-	input := dataset10k()
+// benchEngine benchmarks the engine registered under id on input.
+func benchEngine(b *testing.B, id, input string) {
+	e := engine(b, id)
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		v3.Transliterate(input)
+		e.Transliterate(input)
 	}
 }
 
-func BenchmarkTranslit4(b *testing.B) {
-	input := dataset10k()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v4.Transliterate(input)
-	}
-}
+// --- Unified 10k-word benchmarks (same dataset for all versions) ---
+
+func BenchmarkTranslit1(b *testing.B) { benchEngine(b, translit.IDOriginal, dataset10k()) }
+func BenchmarkTranslit2(b *testing.B) { benchEngine(b, translit.IDMap, dataset10k()) }
+func BenchmarkTranslit3(b *testing.B) { benchEngine(b, translit.IDQawaaidu, dataset10k()) }
+func BenchmarkTranslit4(b *testing.B) { benchEngine(b, translit.IDFast, dataset10k()) }
 
-// TestWriteBenchmarkCSV runs the four BenchmarkTranslit* benchmarks and writes
-// results to benchmark_results.csv in the project root. Run with:
+// TestWriteBenchmarkCSV benchmarks every engine of benchmarkedIDs on the 10k
+// dataset and writes results to benchmark_results.csv in the project root.
+// Run with:
 //   go test ./benchmark/ -run TestWriteBenchmarkCSV -v
 func TestWriteBenchmarkCSV(t *testing.T) {
 	input := dataset10k()
-	benches := []struct {
-		name string
-		fn   func(b *testing.B)
-	}{
-		{"translit1", func(b *testing.B) { for i := 0; i < b.N; i++ { v1.Transliterate(input) } }},
-		{"translit2", func(b *testing.B) { for i := 0; i < b.N; i++ { v2.Transliterate(input) } }},
-		{"translit3", func(b *testing.B) { for i := 0; i < b.N; i++ { v3.Transliterate(input) } }},
-		{"translit4", func(b *testing.B) { for i := 0; i < b.N; i++ { v4.Transliterate(input) } }},
-	}
 	var out strings.Builder
 	out.WriteString("version,ns_per_op,allocs_per_op,bytes_per_op\n")
-	for _, bench := range benches {
-		res := testing.Benchmark(bench.fn)
-		out.WriteString(bench.name + ",")
+	for _, e := range registeredEngines() {
+		res := testing.Benchmark(func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				e.Transliterate(input)
+			}
+		})
+		out.WriteString(e.Name() + ",")
 		out.WriteString(strconv.FormatInt(res.NsPerOp(), 10) + ",")
 		out.WriteString(strconv.FormatInt(int64(res.AllocsPerOp()), 10) + ",")
 		out.WriteString(strconv.FormatInt(res.AllocedBytesPerOp(), 10) + "\n")
@@ -127,83 +136,53 @@ func TestWriteBenchmarkCSV(t *testing.T) {
 // --- V1 Benchmarks ---
 
 func BenchmarkV1_Short(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		v1.Transliterate(shortInput)
-	}
+	benchEngine(b, translit.IDOriginal, shortInput)
 }
 
 func BenchmarkV1_Medium(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		v1.Transliterate(mediumInput)
-	}
+	benchEngine(b, translit.IDOriginal, mediumInput)
 }
 
 func BenchmarkV1_Long(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		v1.Transliterate(longInput)
-	}
+	benchEngine(b, translit.IDOriginal, longInput)
 }
 
 func BenchmarkV1_Repeated100(b *testing.B) {
-	input := buildRepeated(100)
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v1.Transliterate(input)
-	}
+	benchEngine(b, translit.IDOriginal, buildRepeated(100))
 }
 
 // --- V2 Benchmarks ---
 
 func BenchmarkV2_Short(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		v2.Transliterate(shortInput)
-	}
+	benchEngine(b, translit.IDMap, shortInput)
 }
 
 func BenchmarkV2_Medium(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		v2.Transliterate(mediumInput)
-	}
+	benchEngine(b, translit.IDMap, mediumInput)
 }
 
 func BenchmarkV2_Long(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		v2.Transliterate(longInput)
-	}
+	benchEngine(b, translit.IDMap, longInput)
 }
 
 func BenchmarkV2_Repeated100(b *testing.B) {
-	input := buildRepeated(100)
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v2.Transliterate(input)
-	}
+	benchEngine(b, translit.IDMap, buildRepeated(100))
 }
 
 // --- V3 Benchmarks ---
 
 func BenchmarkV3_Short(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		v3.Transliterate(shortInput)
-	}
+	benchEngine(b, translit.IDQawaaidu, shortInput)
 }
 
 func BenchmarkV3_Medium(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		v3.Transliterate(mediumInput)
-	}
+	benchEngine(b, translit.IDQawaaidu, mediumInput)
 }
 
 func BenchmarkV3_Long(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		v3.Transliterate(longInput)
-	}
+	benchEngine(b, translit.IDQawaaidu, longInput)
 }
 
 func BenchmarkV3_Repeated100(b *testing.B) {
-	input := buildRepeated(100)
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v3.Transliterate(input)
-	}
+	benchEngine(b, translit.IDQawaaidu, buildRepeated(100))
 }
@@ -4,46 +4,104 @@ import (
 	"bufio"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"runtime"
+	"strings"
 	"time"
 
-	translit1 "dhivehi-translit/internal/translit1"
-	translit2 "dhivehi-translit/internal/translit2"
-	translit3 "dhivehi-translit/internal/translit3"
-	translit4 "dhivehi-translit/internal/translit4"
+	"dhivehi-translit/translit"
 )
 
+// personEngines are the registered engines that honour Options.PersonNames:
+// translit3 and the profiles built on it.
+var personEngines = map[string]bool{
+	translit.IDQawaaidu:    true,
+	translit.IDSouthern:    true,
+	translit.IDDhivesAkuru: true,
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "sort" {
+		if err := runSort(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	v1 := flag.Bool("v1", false, "use v1 engine")
 	v2 := flag.Bool("v2", false, "use v2 engine")
 	v3 := flag.Bool("v3", false, "use v3 engine")
 	v4 := flag.Bool("v4", false, "use v4 engine (default)")
+	engineID := flag.String("engine", "", "use the engine registered under this ID")
+	list := flag.Bool("list", false, "list registered engine IDs and exit")
+	schemePath := flag.String("scheme", "", "use the v3 engine with a JSON romanization scheme")
+	hyphenate := flag.Bool("hyphenate", false, "insert soft hyphens at syllable boundaries (v4 engine)")
+	spell := flag.Bool("spell", false, "spell out Thaana letter by letter (v3 letter names)")
+	person := flag.Bool("person", false, "write person names with their conventional spelling (v3 engine)")
+	exceptionsPath := flag.String("exceptions", "", "write the words in this TSV exception lexicon with their listed spelling")
+	arabicSpans := flag.Bool("arabic", false, "romanize Arabic-script words, digits and ligatures")
+	ligatures := flag.String("ligatures", "expand", "ligature symbols with -arabic: expand, abbreviate, keep or drop")
+	gazetteer := flag.Bool("gazetteer", false, "write atolls and islands with their official spelling")
+	capitalize := flag.Bool("capitalize", false, "capitalize sentence starts")
+	namesPath := flag.String("names", "", "capitalize the proper nouns listed in this file (implies -capitalize)")
+	marker := flag.String("marker", translit.SoftHyphen, "marker inserted by -hyphenate")
 	timer := flag.Bool("timer", false, "print transliteration runtime to stderr")
 	shortTimer := flag.Bool("t", false, "shorthand for -timer")
 
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: dhivehi-translit [flags] [file]\n\n")
-		fmt.Fprintf(os.Stderr, "Transliterate Dhivehi (Thaana) text to Latin script.\n\n")
+		fmt.Fprintf(os.Stderr, "Usage: dhivehi-translit [flags] [file]\n")
+		fmt.Fprintf(os.Stderr, "       dhivehi-translit sort [-r] [-u] [file]\n\n")
+		fmt.Fprintf(os.Stderr, "Transliterate Dhivehi (Thaana) text to Latin script, or back with -engine %s.\n\n", translit.IDReverse)
 		fmt.Fprintf(os.Stderr, "If a file path is given, its contents are transliterated to stdout.\n")
-		fmt.Fprintf(os.Stderr, "Otherwise reads line-by-line from stdin (interactive or piped).\n\n")
+		fmt.Fprintf(os.Stderr, "Otherwise reads from stdin: streamed when piped, line-by-line when interactive.\n\n")
 		fmt.Fprintf(os.Stderr, "Engine:\n")
 		fmt.Fprintf(os.Stderr, "  -v1    use v1 engine\n")
 		fmt.Fprintf(os.Stderr, "  -v2    use v2 engine\n")
 		fmt.Fprintf(os.Stderr, "  -v3    use v3 engine\n")
-		fmt.Fprintf(os.Stderr, "  -v4    use v4 engine (default)\n\n")
+		fmt.Fprintf(os.Stderr, "  -v4    use v4 engine (default)\n")
+		fmt.Fprintf(os.Stderr, "  -engine ID    use the engine registered under ID (e.g. %s)\n", translit.IDQawaaidu)
+		fmt.Fprintf(os.Stderr, "  -list         list registered engine IDs and exit\n")
+		fmt.Fprintf(os.Stderr, "  -scheme FILE  use the v3 engine with a JSON romanization scheme\n")
+		fmt.Fprintf(os.Stderr, "  -hyphenate    use the v4 engine, inserting soft hyphens at syllable boundaries\n")
+		fmt.Fprintf(os.Stderr, "  -spell        spell out every letter by name (\"baa abafili seenu sukun\")\n\n")
 		fmt.Fprintf(os.Stderr, "Options:\n")
+		fmt.Fprintf(os.Stderr, "  -marker MARK  insert MARK instead of U+00AD with -hyphenate\n")
+		fmt.Fprintf(os.Stderr, "  -person       write person names with their conventional spelling (Mohamed); selects v3 by default\n")
+		fmt.Fprintf(os.Stderr, "                and works only with v3, -scheme and the southern and Dhives Akuru engines\n")
+		fmt.Fprintf(os.Stderr, "  -exceptions FILE\n                write the words in FILE (Thaana<TAB>Latin) with their listed spelling\n")
+		fmt.Fprintf(os.Stderr, "  -arabic       romanize Arabic-script words, convert Arabic-Indic digits, drop tatweel\n")
+		fmt.Fprintf(os.Stderr, "  -ligatures MODE\n                expand (default), abbreviate, keep or drop ligatures such as U+FDFA with -arabic\n")
+		fmt.Fprintf(os.Stderr, "  -gazetteer    write atolls and islands with their official spelling (Malé)\n")
+		fmt.Fprintf(os.Stderr, "  -capitalize   capitalize the first word of every sentence\n")
+		fmt.Fprintf(os.Stderr, "  -names FILE   also capitalize the proper nouns in FILE, one per line\n")
 		fmt.Fprintf(os.Stderr, "  -t, -timer    print transliteration runtime to stderr\n\n")
 		fmt.Fprintf(os.Stderr, "Examples:\n")
 		fmt.Fprintf(os.Stderr, "  dhivehi-translit input.txt\n")
 		fmt.Fprintf(os.Stderr, "  echo \"ދިވެހި\" | dhivehi-translit\n")
 		fmt.Fprintf(os.Stderr, "  dhivehi-translit -v2 input.txt\n")
+		fmt.Fprintf(os.Stderr, "  dhivehi-translit -engine %s input.txt\n", translit.IDQawaaidu)
+		fmt.Fprintf(os.Stderr, "  echo \"dhivehi\" | dhivehi-translit -engine %s\n", translit.IDReverse)
+		fmt.Fprintf(os.Stderr, "  dhivehi-translit -hyphenate -marker - input.txt\n")
+		fmt.Fprintf(os.Stderr, "  dhivehi-translit -exceptions testdata/exceptions.txt input.txt\n")
+		fmt.Fprintf(os.Stderr, "  dhivehi-translit -gazetteer -names names.txt news.txt\n")
+		fmt.Fprintf(os.Stderr, "  echo \"ބަސް\" | dhivehi-translit -spell\n")
+		fmt.Fprintf(os.Stderr, "  dhivehi-translit sort words.txt\n")
 	}
 
 	flag.Parse()
 
 	showTimer := *timer || *shortTimer
 
+	if *list {
+		for _, id := range translit.Engines() {
+			e, _ := translit.Lookup(id)
+			fmt.Printf("%-24s %s (%s)\n", id, e.Name(), e.Version())
+		}
+		return
+	}
+
 	vCount := 0
 	if *v1 {
 		vCount++
@@ -57,68 +115,185 @@ func main() {
 	if *v4 {
 		vCount++
 	}
+	if *engineID != "" {
+		vCount++
+	}
+	if *schemePath != "" {
+		vCount++
+	}
+	if *hyphenate {
+		vCount++
+	}
+	if *spell {
+		vCount++
+	}
 	if vCount > 1 {
-		fmt.Fprintln(os.Stderr, "error: specify only one of -v1, -v2, -v3, or -v4")
+		fmt.Fprintln(os.Stderr, "error: specify only one of -v1, -v2, -v3, -v4, -engine, -scheme, -hyphenate, or -spell")
 		os.Exit(1)
 	}
 
-	var transliterate func(string) string
-	engineName := "v4"
-
+	id := translit.Default
+	if *person {
+		id = translit.IDQawaaidu
+	}
 	switch {
 	case *v1:
-		transliterate = translit1.Transliterate
-		engineName = "v1"
+		id = translit.IDOriginal
 	case *v2:
-		transliterate = translit2.Transliterate
-		engineName = "v2"
+		id = translit.IDMap
 	case *v3:
-		transliterate = translit3.Transliterate
-		engineName = "v3"
-	default:
-		transliterate = translit4.Transliterate
+		id = translit.IDQawaaidu
+	case *v4:
+		id = translit.IDFast
+	case *engineID != "":
+		id = *engineID
+	}
+	if *person && (*hyphenate || *spell || *schemePath == "" && !personEngines[id]) {
+		fmt.Fprintln(os.Stderr, "error: -person needs a translit3-based engine: -v3, -scheme, or -engine "+
+			translit.IDQawaaidu+", "+translit.IDSouthern+" or "+translit.IDDhivesAkuru)
+		os.Exit(1)
 	}
 
+	engine, err := translit.Lookup(id)
+	if *schemePath != "" {
+		var cfg translit.Config
+		cfg, err = translit.LoadScheme(*schemePath)
+		engine = translit.New(cfg)
+	}
+	if *hyphenate {
+		engine = translit.Hyphenator(*marker)
+	}
+	if *spell {
+		engine = translit.Speller()
+	}
+	if err == nil && *arabicSpans {
+		cfg := translit.ArabicConfig{}
+		switch *ligatures {
+		case "expand":
+			cfg.Ligatures = translit.LigatureExpand
+		case "abbreviate":
+			cfg.Ligatures = translit.LigatureAbbreviate
+		case "keep":
+			cfg.Ligatures = translit.LigatureKeep
+		case "drop":
+			cfg.Ligatures = translit.LigatureDrop
+		default:
+			err = fmt.Errorf("unknown -ligatures mode %q", *ligatures)
+		}
+		engine = translit.WithArabic(engine, cfg)
+	}
+	if err == nil && *gazetteer {
+		engine = translit.WithGazetteer(engine, nil)
+	}
+	if err == nil && *exceptionsPath != "" {
+		var x *translit.Exceptions
+		if x, err = translit.LoadExceptions(*exceptionsPath); err == nil {
+			engine = translit.WithExceptions(engine, x)
+		}
+	}
+	if err == nil && (*capitalize || *namesPath != "") {
+		var names []string
+		if *namesPath != "" {
+			names, err = readNames(*namesPath)
+		}
+		engine = translit.Capitalized(engine, translit.NewCapitalizer(names))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	engineName := engine.Version()
+	opts := translit.Options{PersonNames: *person}
+
 	args := flag.Args()
 	if len(args) > 0 {
-		input, err := os.ReadFile(args[0])
+		f, err := os.Open(args[0])
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "error: %v\n", err)
 			os.Exit(1)
 		}
+		defer f.Close()
 
-		start := time.Now()
-		result := transliterate(string(input))
-		elapsed := time.Since(start)
-
-		fmt.Print(result)
-		if showTimer {
-			fmt.Fprintf(os.Stderr, "[%s] %v (%.3f ms)\n", engineName, elapsed, float64(elapsed.Nanoseconds())/1e6)
+		if err := stream(f, engine, opts, engineName, showTimer); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
 		}
-	} else {
-		fi, _ := os.Stdin.Stat()
-		if (fi.Mode() & os.ModeCharDevice) != 0 {
-			eofHint := "Ctrl+D"
-			if runtime.GOOS == "windows" {
-				eofHint = "Ctrl+Z then Enter"
-			}
-			fmt.Fprintf(os.Stderr, "Type Thaana text (%s to exit):\n", eofHint)
+		return
+	}
+
+	fi, _ := os.Stdin.Stat()
+	if (fi.Mode() & os.ModeCharDevice) == 0 {
+		// Piped input: stream it, so arbitrarily long lines are fine.
+		if err := stream(os.Stdin, engine, opts, engineName, showTimer); err != nil {
+			fmt.Fprintf(os.Stderr, "error reading stdin: %v\n", err)
+			os.Exit(1)
 		}
+		return
+	}
+
+	eofHint := "Ctrl+D"
+	if runtime.GOOS == "windows" {
+		eofHint = "Ctrl+Z then Enter"
+	}
+	fmt.Fprintf(os.Stderr, "Type Thaana text (%s to exit):\n", eofHint)
 
-		scanner := bufio.NewScanner(os.Stdin)
-		for scanner.Scan() {
+	in := bufio.NewReader(os.Stdin)
+	for {
+		line, err := in.ReadString('\n')
+		if line != "" {
 			start := time.Now()
-			result := transliterate(scanner.Text())
+			result := engine.TransliterateWithOptions(strings.TrimSuffix(line, "\n"), opts)
 			elapsed := time.Since(start)
 
 			fmt.Println(result)
 			if showTimer {
-				fmt.Fprintf(os.Stderr, "[%s] %v (%.3f ms)\n", engineName, elapsed, float64(elapsed.Nanoseconds())/1e6)
+				printTimer(engineName, elapsed)
 			}
 		}
-		if err := scanner.Err(); err != nil {
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "error reading stdin: %v\n", err)
 			os.Exit(1)
 		}
 	}
 }
+
+// stream transliterates r to stdout without loading it into memory.
+func stream(r io.Reader, engine translit.Engine, opts translit.Options, engineName string, showTimer bool) error {
+	out := bufio.NewWriter(os.Stdout)
+
+	start := time.Now()
+	_, err := io.Copy(out, translit.NewReader(r, engine, opts))
+	if err == nil {
+		err = out.Flush()
+	}
+	elapsed := time.Since(start)
+
+	if showTimer {
+		printTimer(engineName, elapsed)
+	}
+	return err
+}
+
+// readNames reads a proper-noun list: one name per line, with blank lines
+// and lines starting with "#" ignored.
+func readNames(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			names = append(names, line)
+		}
+	}
+	return names, nil
+}
+
+func printTimer(engineName string, elapsed time.Duration) {
+	fmt.Fprintf(os.Stderr, "[%s] %v (%.3f ms)\n", engineName, elapsed, float64(elapsed.Nanoseconds())/1e6)
+}
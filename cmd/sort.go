@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"dhivehi-translit/translit"
+)
+
+// runSort implements the sort subcommand: it prints the lines of a file, or
+// of stdin, in Thaana alphabetical order.
+func runSort(args []string) error {
+	fs := flag.NewFlagSet("sort", flag.ExitOnError)
+	reverse := fs.Bool("r", false, "reverse the order")
+	unique := fs.Bool("u", false, "print each distinct line once")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: dhivehi-translit sort [-r] [-u] [file]\n\n")
+		fmt.Fprintf(os.Stderr, "Sort lines in Thaana alphabetical order (haa, shaviyani, noonu, raa, …).\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	in := io.Reader(os.Stdin)
+	if fs.NArg() > 0 {
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var lines []string
+	r := bufio.NewReader(in)
+	for {
+		line, err := r.ReadString('\n')
+		if line != "" {
+			lines = append(lines, strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"))
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	translit.Sort(lines)
+	if *unique {
+		lines = slices.Compact(lines)
+	}
+	if *reverse {
+		slices.Reverse(lines)
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	for _, line := range lines {
+		fmt.Fprintln(out, line)
+	}
+	return out.Flush()
+}
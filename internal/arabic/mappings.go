@@ -0,0 +1,142 @@
+package transliterator
+
+// Letters with rule-based handling.
+const (
+	Alif        rune = 'ا'
+	AlifWasla   rune = 'ٱ'
+	AlifHamza   rune = 'أ'
+	AlifHamzaLo rune = 'إ'
+	AlifMadda   rune = 'آ'
+	AlifMaqsura rune = 'ى'
+	TaaMarbuta  rune = 'ة'
+	Lam         rune = 'ل'
+	Waw         rune = 'و'
+	Yaa         rune = 'ي'
+	FarsiYeh    rune = 'ی'
+	Tatweel     rune = 'ـ'
+
+	Fathatan   rune = '\u064B'
+	Dammatan   rune = '\u064C'
+	Kasratan   rune = '\u064D'
+	Fatha      rune = '\u064E'
+	Damma      rune = '\u064F'
+	Kasra      rune = '\u0650'
+	Shadda     rune = '\u0651'
+	Sukun      rune = '\u0652'
+	DaggerAlif rune = '\u0670' // superscript alif
+)
+
+// Arabic letters → Latin, in the plain spelling used for Arabic phrases in
+// Dhivehi writing: no diacritics, ع and hamza as an apostrophe. The alif
+// forms, و, ي, ى and ة depend on context and are handled in RomanizeWord.
+var consonantData = map[rune]string{
+	'ء': "'",
+	'ؤ': "'",
+	'ئ': "'",
+	'ب': "b",
+	'ت': "t",
+	'ث': "th",
+	'ج': "j",
+	'ح': "h",
+	'خ': "kh",
+	'د': "d",
+	'ذ': "dh",
+	'ر': "r",
+	'ز': "z",
+	'س': "s",
+	'ش': "sh",
+	'ص': "s",
+	'ض': "d",
+	'ط': "t",
+	'ظ': "z",
+	'ع': "'",
+	'غ': "gh",
+	'ف': "f",
+	'ق': "q",
+	'ك': "k",
+	'ل': "l",
+	'م': "m",
+	'ن': "n",
+	'ه': "h",
+	'و': "w",
+	'ي': "y",
+
+	// Persian and Urdu letters
+	'پ': "p",
+	'چ': "ch",
+	'ژ': "zh",
+	'ک': "k",
+	'گ': "g",
+	'ی': "y",
+}
+
+// Harakat → Latin. Shadda, sukun and the dagger alif are handled in
+// RomanizeWord.
+var vowelData = map[rune]string{
+	Fatha:    "a",
+	Kasra:    "i",
+	Damma:    "u",
+	Fathatan: "an",
+	Kasratan: "in",
+	Dammatan: "un",
+}
+
+// sunLetters assimilate the l of the article: ٱلرَّحْمَٰن → "ar-rahmaan".
+var sunLetters = map[rune]bool{
+	'ت': true, 'ث': true, 'د': true, 'ذ': true, 'ر': true, 'ز': true, 'س': true,
+	'ش': true, 'ص': true, 'ض': true, 'ط': true, 'ظ': true, 'ل': true, 'ن': true,
+}
+
+// allahData gives the romanization of the name of God, alone and with the
+// prefixes bi-, li-, wa- and fa-, keyed by its letters without marks. The
+// case vowel on the final ه is added in RomanizeWord.
+var allahData = map[string]string{
+	"الله":  "allaah",
+	"ٱلله":  "allaah",
+	"لله":   "lillaah",
+	"بالله": "billaah",
+	"بٱلله": "billaah",
+	"والله": "wallaah",
+	"وٱلله": "wallaah",
+	"فالله": "fallaah",
+	"فٱلله": "fallaah",
+}
+
+// digitData maps Arabic-Indic and Eastern Arabic-Indic digits, and the
+// Arabic number signs, to ASCII.
+var digitData = map[rune]rune{
+	'٠': '0', '١': '1', '٢': '2', '٣': '3', '٤': '4',
+	'٥': '5', '٦': '6', '٧': '7', '٨': '8', '٩': '9',
+	'۰': '0', '۱': '1', '۲': '2', '۳': '3', '۴': '4',
+	'۵': '5', '۶': '6', '۷': '7', '۸': '8', '۹': '9',
+	'٫': '.', // decimal separator
+	'٬': ',', // thousands separator
+	'٪': '%',
+}
+
+// ligatureExpanded and ligatureAbbreviated give the romanized phrase and the
+// customary English abbreviation of the Arabic ligature symbols.
+var ligatureExpanded = map[rune]string{
+	'\uFDF0': "salla",                         // ﷰ
+	'\uFDF1': "qala",                          // ﷱ
+	'\uFDF2': "allaah",                        // ﷲ
+	'\uFDF3': "akbar",                         // ﷳ
+	'\uFDF4': "muhammad",                      // ﷴ
+	'\uFDF5': "salaam",                        // ﷵ
+	'\uFDF6': "rasool",                        // ﷶ
+	'\uFDF7': "alaihi",                        // ﷷ
+	'\uFDF8': "wa sallam",                     // ﷸ
+	'\uFDF9': "salla",                         // ﷹ
+	'\uFDFA': "sallallaahu alaihi wa sallam",  // ﷺ
+	'\uFDFB': "jalla jalaaluhu",               // ﷻ
+	'\uFDFC': "riyal",                         // ﷼
+	'\uFDFD': "bismillaahir rahmaanir raheem", // ﷽
+	'\uFDFE': "subhaanahu wa ta'aalaa",
+	'\uFDFF': "'azza wa jalla",
+}
+
+var ligatureAbbreviated = map[rune]string{
+	'\uFDFA': "(SAW)",
+	'\uFDFD': "Bismillah",
+	'\uFDFE': "(SWT)",
+}
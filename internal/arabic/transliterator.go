@@ -0,0 +1,297 @@
+package transliterator
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// IsLetter reports whether r is part of an Arabic word: a letter of the
+// Arabic or Arabic Supplement block, or a mark written on one. Tatweel,
+// digits and punctuation are not.
+func IsLetter(r rune) bool {
+	switch {
+	case r >= 0x0621 && r <= 0x063A,
+		r >= 0x0641 && r <= 0x065F,
+		r >= 0x0670 && r <= 0x06D3,
+		r >= 0x06D5 && r <= 0x06ED,
+		r >= 0x06FA && r <= 0x06FC,
+		r >= 0x0750 && r <= 0x077F:
+		return true
+	}
+	return false
+}
+
+// IsLigature reports whether r is one of the Arabic ligature symbols
+// U+FDF0–U+FDFF (ﷲ, ﷺ, ﷽…).
+func IsLigature(r rune) bool {
+	return r >= 0xFDF0 && r <= 0xFDFF
+}
+
+// isMark reports whether r is a haraka, shadda, sukun or Quranic annotation.
+func isMark(r rune) bool {
+	return r >= 0x064B && r <= 0x065F || r == DaggerAlif || r >= 0x06D6 && r <= 0x06ED
+}
+
+// Ligature returns the romanized phrase of the ligature symbol r, or its
+// customary abbreviation ("(SAW)" for ﷺ) if abbreviated is set and it has one.
+func Ligature(r rune, abbreviated bool) (string, bool) {
+	if abbreviated {
+		if s, ok := ligatureAbbreviated[r]; ok {
+			return s, true
+		}
+	}
+	s, ok := ligatureExpanded[r]
+	return s, ok
+}
+
+// Normalize converts Arabic-Indic and Eastern Arabic-Indic digits (and the
+// Arabic decimal, thousands and percent signs) to ASCII and removes tatweel,
+// the letter-stretching character.
+func Normalize(s string) string {
+	if !strings.ContainsFunc(s, func(r rune) bool { return r == Tatweel || digitData[r] != 0 }) {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if r == Tatweel {
+			return -1
+		}
+		if d, ok := digitData[r]; ok {
+			return d
+		}
+		return r
+	}, s)
+}
+
+// Romanize romanizes the vocalized Arabic words and ligature symbols in s,
+// after Normalize, and maps ، ؛ ؟ to Latin punctuation. Unvocalized words
+// and other text are kept.
+func Romanize(s string) string {
+	s = Normalize(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case IsLetter(r):
+			j := i + size
+			for j < len(s) {
+				r, size := utf8.DecodeRuneInString(s[j:])
+				if !IsLetter(r) {
+					break
+				}
+				j += size
+			}
+			b.WriteString(RomanizeWord(s[i:j]))
+			i = j
+			continue
+		case IsLigature(r):
+			if lat, ok := Ligature(r, false); ok {
+				b.WriteString(lat)
+			} else {
+				b.WriteRune(r)
+			}
+		case r == '،':
+			b.WriteByte(',')
+		case r == '؛':
+			b.WriteByte(';')
+		case r == '؟':
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+		i += size
+	}
+	return b.String()
+}
+
+// RomanizeWord romanizes one Arabic word (a run of IsLetter runes).
+//
+// The word is read letter by letter: harakat give short vowels, shadda
+// doubles, alif, و and ي after the matching haraka give aa, oo and ee, and a
+// fatha before و or ي gives au or ai. The article is written "al-" and
+// assimilates before sun letters (ٱلرَّحْمَٰنِ → "ar-rahmaani"). The name of
+// God is written "allaah", with its case vowel (ٱللَّهِ → "allaahi",
+// لِلَّهِ → "lillaahi").
+//
+// Unvocalized words, which carry no harakat, cannot be read reliably and
+// are returned unchanged (كتاب stays كتاب); the name of God is the only
+// exception.
+func RomanizeWord(word string) string {
+	runes := []rune(word)
+	n := len(runes)
+
+	if lat, ok := allahData[strings.Map(func(r rune) rune {
+		if isMark(r) {
+			return -1
+		}
+		return r
+	}, word)]; ok {
+		// The case vowel is the last haraka on the final ه.
+		for i := n - 1; i >= 0 && isMark(runes[i]); i-- {
+			if v, ok := vowelData[runes[i]]; ok {
+				return lat + v
+			}
+		}
+		return lat
+	}
+	if !strings.ContainsFunc(word, isMark) {
+		return word
+	}
+	b := make([]byte, 0, len(word)*2)
+
+	i := 0
+	assimilated := false
+	if n > 2 && (runes[0] == Alif || runes[0] == AlifWasla) {
+		k := 1
+		for k < n && isMark(runes[k]) {
+			k++
+		}
+		j := k + 1
+		for j < n && isMark(runes[j]) {
+			j++
+		}
+		if k < n && runes[k] == Lam && j < n {
+			if sunLetters[runes[j]] {
+				b = append(b, 'a')
+				b = append(b, consonantData[runes[j]]...)
+				b = append(b, '-')
+				assimilated = true
+			} else {
+				b = append(b, "al-"...)
+			}
+			i = j
+		}
+	}
+	start := i
+
+	var (
+		prevMark      rune // last mark on the previous letter, 0 if none
+		prevConsonant bool // previous letter was read as a consonant
+	)
+	for i < n {
+		r := runes[i]
+		j := i + 1
+		for j < n && isMark(runes[j]) {
+			j++
+		}
+		marks := runes[i+1 : j]
+		if isMark(r) {
+			// Stray mark without a letter.
+			b = appendMarks(b, runes[i:j])
+			i = j
+			continue
+		}
+
+		shadda, voweled := false, false
+		for _, m := range marks {
+			switch m {
+			case Shadda:
+				shadda = true
+			case Fatha, Kasra, Damma, Fathatan, Kasratan, Dammatan, DaggerAlif:
+				voweled = true
+			}
+		}
+		consonant := false
+
+		switch r {
+		case Alif, AlifWasla, AlifMaqsura:
+			switch {
+			case i == start:
+				if !voweled {
+					b = append(b, 'a')
+				}
+			case prevMark == Fathatan:
+				// Silent alif after tanwin: كِتَابًا → "kitaaban".
+			default:
+				b = lengthen(b, 'a')
+			}
+		case AlifHamza, AlifHamzaLo:
+			if i > start {
+				b = append(b, '\'')
+			}
+			if !voweled {
+				if r == AlifHamzaLo {
+					b = append(b, 'i')
+				} else {
+					b = append(b, 'a')
+				}
+			}
+		case AlifMadda:
+			if i > start {
+				b = append(b, '\'')
+			}
+			b = append(b, "aa"...)
+		case TaaMarbuta:
+			if voweled {
+				b = append(b, 't')
+			} else {
+				b = append(b, 'h')
+			}
+		case Waw, Yaa, FarsiYeh:
+			long := byte('o')
+			if r != Waw {
+				long = 'e'
+			}
+			switch {
+			case voweled || shadda || i == start:
+				lat := consonantData[r]
+				b = append(b, lat...)
+				if shadda && !assimilated {
+					b = append(b, lat...)
+				}
+				consonant = true
+			case prevMark == Damma && r == Waw, prevMark == Kasra && r != Waw:
+				b = append(b[:len(b)-1], long, long)
+			case prevMark == Fatha:
+				if r == Waw {
+					b = append(b, 'u')
+				} else {
+					b = append(b, 'i')
+				}
+			case prevMark == 0 && prevConsonant:
+				b = append(b, long, long)
+			default:
+				b = append(b, consonantData[r]...)
+				consonant = true
+			}
+		default:
+			lat := consonantData[r]
+			b = append(b, lat...)
+			if shadda && !assimilated {
+				b = append(b, lat...)
+			}
+			consonant = lat != ""
+		}
+
+		b = appendMarks(b, marks)
+		prevMark = 0
+		if len(marks) > 0 {
+			prevMark = marks[len(marks)-1]
+		}
+		prevConsonant = consonant
+		assimilated = false
+		i = j
+	}
+	return string(b)
+}
+
+// appendMarks appends the vowels written by marks.
+func appendMarks(b []byte, marks []rune) []byte {
+	for _, m := range marks {
+		if m == DaggerAlif {
+			b = lengthen(b, 'a')
+		} else {
+			b = append(b, vowelData[m]...)
+		}
+	}
+	return b
+}
+
+// lengthen appends the long vowel v: one more v after a short v, else two.
+func lengthen(b []byte, v byte) []byte {
+	if len(b) > 0 && b[len(b)-1] == v {
+		return append(b, v)
+	}
+	return append(b, v, v)
+}
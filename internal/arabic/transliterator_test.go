@@ -0,0 +1,65 @@
+package transliterator
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRomanize(t *testing.T) {
+	f, err := os.Open("../../testdata/golden_arabic.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		input, expected, _ := strings.Cut(line, "\t")
+		t.Run(input, func(t *testing.T) {
+			if result := Romanize(input); result != expected {
+				t.Errorf("got %q, want %q", result, expected)
+			}
+		})
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"١٤٤٥", "1445"},
+		{"۲۰۲۴", "2024"},
+		{"ދިވެހި", "ދިވެހި"},
+		{"جـميل", "جميل"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := Normalize(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLigature(t *testing.T) {
+	if s, _ := Ligature('ﷺ', true); s != "(SAW)" {
+		t.Errorf("abbreviated ﷺ = %q", s)
+	}
+	if s, _ := Ligature('ﷲ', true); s != "allaah" {
+		t.Errorf("abbreviated ﷲ = %q, want the expansion", s)
+	}
+	if _, ok := Ligature('ا', false); ok {
+		t.Error("alif is not a ligature")
+	}
+}
@@ -0,0 +1,97 @@
+package transliterator
+
+// Signs with rule-based handling. Dhives Akuru is rarely covered by fonts,
+// so the tables below use escapes with the Unicode names as comments.
+const (
+	VowelSignAI   rune = '\U00011937'
+	Anusvara      rune = '\U0001193B'
+	Candrabindu   rune = '\U0001193C'
+	Halanta       rune = '\U0001193D' // visible vowel killer
+	Virama        rune = '\U0001193E' // invisible, forms conjuncts
+	PrefixedNasal rune = '\U0001193F'
+	MedialYa      rune = '\U00011940'
+	InitialRa     rune = '\U00011941'
+	MedialRa      rune = '\U00011942'
+	Nukta         rune = '\U00011943'
+	DoubleDanda   rune = '\U00011944'
+	GapFiller     rune = '\U00011945'
+	EndOfText     rune = '\U00011946'
+	DigitZero     rune = '\U00011950'
+	DigitNine     rune = '\U00011959'
+)
+
+// Thaana letters and fili used when writing syllables.
+const (
+	alifu    = "އ"
+	noonu    = "ނ"
+	abafili  = "ަ"
+	sukun    = "ް"
+	yaviyani = "ޔ"
+	raa      = "ރ"
+)
+
+// Dhives Akuru consonants → modern Thaana akuru. Aspirated letters, found in
+// Sanskrit and Pali loans, have no Thaana counterpart and take the letter of
+// the plain stop; PHA takes faafu, the modern reflex of old ph. NNA is NAA
+// (ޱ), which survives in southern-dialect writing.
+var consonantData = map[rune]string{
+	'\U0001190C': "ކ", // KA
+	'\U0001190D': "ކ", // KHA
+	'\U0001190E': "ގ", // GA
+	'\U0001190F': "ގ", // GHA
+	'\U00011910': "ނ", // NGA
+	'\U00011911': "ޗ", // CA
+	'\U00011912': "ޗ", // CHA
+	'\U00011913': "ޖ", // JA
+	'\U00011915': "ޏ", // NYA
+	'\U00011916': "ޓ", // TTA
+	'\U00011918': "ޑ", // DDA
+	'\U00011919': "ޑ", // DDHA
+	'\U0001191A': "ޱ", // NNA
+	'\U0001191B': "ތ", // TA
+	'\U0001191C': "ތ", // THA
+	'\U0001191D': "ދ", // DA
+	'\U0001191E': "ދ", // DHA
+	'\U0001191F': "ނ", // NA
+	'\U00011920': "ޕ", // PA
+	'\U00011921': "ފ", // PHA
+	'\U00011922': "ބ", // BA
+	'\U00011923': "ބ", // BHA
+	'\U00011924': "މ", // MA
+	'\U00011925': "ޔ", // YA
+	'\U00011926': "ޔ", // YYA
+	'\U00011927': "ރ", // RA
+	'\U00011928': "ލ", // LA
+	'\U00011929': "ވ", // VA
+	'\U0001192A': "ށ", // SHA
+	'\U0001192B': "ށ", // SSA
+	'\U0001192C': "ސ", // SA
+	'\U0001192D': "ހ", // HA
+	'\U0001192E': "ޅ", // LLA
+	'\U0001192F': "ޒ", // ZA
+}
+
+// Independent vowels → Alifu carrying the matching fili. Dhives Akuru has a
+// single E and O, written with the short fili.
+var independentVowelData = map[rune]string{
+	'\U00011900': "އަ", // A
+	'\U00011901': "އާ", // AA
+	'\U00011902': "އި", // I
+	'\U00011903': "އީ", // II
+	'\U00011904': "އު", // U
+	'\U00011905': "އޫ", // UU
+	'\U00011906': "އެ", // E
+	'\U00011909': "އޮ", // O
+}
+
+// Dependent vowel signs → fili.
+var vowelSignData = map[rune]string{
+	'\U00011930': "ާ",   // AA
+	'\U00011931': "ި",   // I
+	'\U00011932': "ީ",   // II
+	'\U00011933': "ު",   // U
+	'\U00011934': "ޫ",   // UU
+	'\U00011935': "ެ",   // E
+	VowelSignAI:  "ައި", // AI, written as a + alifu + i
+	'\U00011938': "ޮ",   // O
+}
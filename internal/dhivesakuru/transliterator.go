@@ -0,0 +1,102 @@
+package transliterator
+
+import "strings"
+
+// IsDhivesAkuru reports whether r is in the Dhives Akuru block
+// U+11900–U+1195F.
+func IsDhivesAkuru(r rune) bool {
+	return r >= 0x11900 && r <= 0x1195F
+}
+
+// ToThaana rewrites the Dhives Akuru text in s in modern Thaana spelling.
+// Other text, Thaana included, is kept as it is.
+//
+// A consonant carries the inherent vowel (abafili) unless a vowel sign,
+// virama or halanta follows. A killed consonant takes sukun, except that a
+// consonant killed before itself is a geminate and is written with Alifu +
+// sukun (𑤠𑤾𑤠 → އްޕ), or with sukun on Noonu for nn. Medial YA and RA add
+// ޔ or ރ after a sukun, initial RA is ރް, the prefixed nasal sign is a bare
+// Noonu (the prenasalized stops of ކަނޑި) and anusvara and candrabindu are
+// ން. Independent vowels are written on Alifu, digits become ASCII, the
+// double danda and end-of-text mark become a full stop and the gap filler
+// and nukta are dropped.
+func ToThaana(s string) string {
+	if !strings.ContainsFunc(s, IsDhivesAkuru) {
+		return s
+	}
+
+	runes := []rune(s)
+	b := make([]byte, 0, len(s))
+	var (
+		open bool   // a consonant was written and has no vowel yet
+		last string // Thaana of the last consonant written
+		at   int    // offset of last in b
+	)
+	inherent := func() {
+		if open {
+			b = append(b, abafili...)
+			open = false
+		}
+	}
+	for i, r := range runes {
+		if th, ok := consonantData[r]; ok {
+			inherent()
+			last, at = th, len(b)
+			b = append(b, th...)
+			open = true
+			continue
+		}
+		switch {
+		case vowelSignData[r] != "":
+			if !open {
+				b = append(b, alifu...)
+			}
+			b = append(b, vowelSignData[r]...)
+			open = false
+		case r == Virama || r == Halanta:
+			if !open {
+				continue
+			}
+			open = false
+			if i+1 < len(runes) && consonantData[runes[i+1]] == last && last != noonu {
+				b = append(b[:at], alifu...)
+			}
+			b = append(b, sukun...)
+		case r == MedialYa || r == MedialRa:
+			th := yaviyani
+			if r == MedialRa {
+				th = raa
+			}
+			if open {
+				b = append(b, sukun...)
+			}
+			last, at = th, len(b)
+			b = append(b, th...)
+			open = true
+		case r == Nukta, r == GapFiller:
+		case r == InitialRa:
+			inherent()
+			b = append(b, raa+sukun...)
+		case r == PrefixedNasal:
+			inherent()
+			b = append(b, noonu...)
+		case r == Anusvara || r == Candrabindu:
+			inherent()
+			b = append(b, noonu+sukun...)
+		case independentVowelData[r] != "":
+			inherent()
+			b = append(b, independentVowelData[r]...)
+		case r == DoubleDanda || r == EndOfText:
+			inherent()
+			b = append(b, '.')
+		case r >= DigitZero && r <= DigitNine:
+			inherent()
+			b = append(b, byte('0'+r-DigitZero))
+		default:
+			inherent()
+			b = append(b, string(r)...)
+		}
+	}
+	inherent()
+	return string(b)
+}
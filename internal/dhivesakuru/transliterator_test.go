@@ -0,0 +1,55 @@
+package transliterator
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestToThaana(t *testing.T) {
+	f, err := os.Open("../../testdata/golden_dhivesakuru.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		input, expected, _ := strings.Cut(line, "\t")
+		t.Run(input, func(t *testing.T) {
+			if result := ToThaana(input); result != expected {
+				t.Errorf("got %q, want %q", result, expected)
+			}
+		})
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestToThaanaKeepsOtherText(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ދިވެހި", "ދިވެހި"},
+		{"Malé", "Malé"},
+		{"\U0001192C\U00011931 ރީ", "ސި ރީ"},
+		{"\U00011933", "އު"},
+		{"\U0001193E\U0001190C", "ކަ"},
+		{"\U00011945\U0001190C\U00011943", "ކަ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := ToThaana(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package transliterator
+
+// Rune constants for rule-based handling.
+const (
+	Sukun  rune = 'ް'
+	Alifu  rune = 'އ'
+	Noonu  rune = 'ނ'
+	Thaalu rune = 'ތ'
+	Ainu   rune = 'ޢ'
+)
+
+type latinMapping struct {
+	lat   string
+	thaan rune
+}
+
+// Latin consonant spellings → akuru, longest spellings first so the greedy
+// matcher prefers "sh'" over "sh" over "s". Both "kh'" (translit3) and "kh"
+// (translit2/translit4) map to khaa.
+var consonantData = []latinMapping{
+	{"th'", 'ޘ'},
+	{"kh'", 'ޚ'},
+	{"dh'", 'ޛ'},
+	{"sh'", 'ޝ'},
+
+	{"h'", 'ޙ'},
+	{"s'", 'ޞ'},
+	{"l'", 'ޟ'},
+	{"t'", 'ޠ'},
+	{"z'", 'ޡ'},
+	{"sh", 'ށ'},
+	{"lh", 'ޅ'},
+	{"dh", 'ދ'},
+	{"th", 'ތ'},
+	{"gn", 'ޏ'},
+	{"ch", 'ޗ'},
+	{"kh", 'ޚ'},
+	{"gh", 'ޣ'},
+
+	{"h", 'ހ'},
+	{"n", 'ނ'},
+	{"r", 'ރ'},
+	{"b", 'ބ'},
+	{"k", 'ކ'},
+	{"v", 'ވ'},
+	{"m", 'މ'},
+	{"f", 'ފ'},
+	{"l", 'ލ'},
+	{"g", 'ގ'},
+	{"s", 'ސ'},
+	{"d", 'ޑ'},
+	{"z", 'ޒ'},
+	{"t", 'ޓ'},
+	{"y", 'ޔ'},
+	{"p", 'ޕ'},
+	{"j", 'ޖ'},
+	{"q", 'ޤ'},
+	{"w", 'ޥ'},
+}
+
+// Latin vowel spellings → fili, long vowels first.
+var vowelData = []latinMapping{
+	{"aa", 'ާ'},
+	{"ee", 'ީ'},
+	{"oo", 'ޫ'},
+	{"ey", 'ޭ'},
+	{"oa", 'ޯ'},
+
+	{"a", 'ަ'},
+	{"i", 'ި'},
+	{"u", 'ު'},
+	{"e", 'ެ'},
+	{"o", 'ޮ'},
+}
+
+// Latin punctuation → Arabic punctuation (inverse of the engines' Nishaan).
+var nishaanData = map[byte]rune{
+	'?': '؟',
+	',': '،',
+	';': '؛',
+}
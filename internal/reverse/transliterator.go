@@ -0,0 +1,222 @@
+package transliterator
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// match returns the first mapping in table whose Latin spelling prefixes s.
+func match(table []latinMapping, s string) (latinMapping, bool) {
+	for _, m := range table {
+		if strings.HasPrefix(s, m.lat) {
+			return m, true
+		}
+	}
+	return latinMapping{}, false
+}
+
+func isVowelByte(c byte) bool {
+	return c == 'a' || c == 'e' || c == 'i' || c == 'o' || c == 'u'
+}
+
+func isLetterByte(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+// atWordEnd reports whether no Latin letter follows position i.
+func atWordEnd(s string, i int) bool {
+	return i >= len(s) || !isLetterByte(s[i])
+}
+
+// loanword reports whether the word starting at i contains an apostrophe-marked
+// Arabic letter or Ainu ("muh'ammadhu", "'aammu"). The "n'" of a bare Noonu
+// does not count.
+func loanword(s string, i int) bool {
+	for ; i < len(s) && (isLetterByte(s[i]) || s[i] == '\''); i++ {
+		if s[i] == '\'' && (i == 0 || s[i-1] != 'n') {
+			return true
+		}
+	}
+	return false
+}
+
+// geminates reports whether consonant spelling next doubles prev, as produced
+// by Alifu + sukun before a consonant ("bappa", "ossan", "ssh").
+func geminates(prev, next string) bool {
+	if prev == next {
+		return true
+	}
+	return len(prev) == 1 && len(next) > 1 && next[0] == prev[0] && next[len(next)-1] != '\''
+}
+
+// Transliterate converts Malé Latin text back to Dhivehi (Thaana).
+//
+// Consonants not followed by a vowel receive sukun; vowels without a
+// preceding consonant are carried on Alifu. Doubled consonants become
+// Alifu + sukun (Noonu + sukun before "n", and before "m" outside Arabic
+// loanwords), "n'" is a bare Noonu,
+// a vowel followed by an apostrophe is carried on Ainu, and a word-final
+// "h" or "iy" after a vowel becomes Alifu or Thaalu + sukun.
+func Transliterate(input string) string {
+	s := strings.ToLower(input)
+	n := len(s)
+
+	var b strings.Builder
+	b.Grow(len(s) * 2)
+
+	var (
+		pending    latinMapping // consonant awaiting its fili or sukun
+		hasPending bool
+		afterVowel bool // last written akuru carried a fili
+		inWord     bool
+		arabic     bool // current word is an Arabic loanword
+	)
+
+	flush := func() {
+		if hasPending {
+			b.WriteRune(pending.thaan)
+			b.WriteRune(Sukun)
+			hasPending = false
+		}
+	}
+
+	i := 0
+	for i < n {
+		c := s[i]
+
+		if !inWord && (isLetterByte(c) || c == '\'') {
+			inWord = true
+			arabic = loanword(s, i)
+		}
+
+		// --- Vowels (Fili) ---
+		if isVowelByte(c) {
+			// Thaalu + sukun: word-final "iy" after a vowel ("baiy", "eyiy")
+			if c == 'i' && afterVowel && !hasPending && i+1 < n && s[i+1] == 'y' && atWordEnd(s, i+2) {
+				b.WriteRune(Thaalu)
+				b.WriteRune(Sukun)
+				afterVowel = false
+				i += 2
+				continue
+			}
+
+			// Ainu + fili: first vowel char, apostrophe, rest ("a'mal", "mue'enu")
+			if i+1 < n && s[i+1] == '\'' {
+				flush()
+				fili, _ := match(vowelData, string(c))
+				l := 2
+				if i+2 < n {
+					if long, ok := match(vowelData[:5], string(c)+string(s[i+2])); ok {
+						fili = long
+						l = 3
+					}
+				}
+				b.WriteRune(Ainu)
+				b.WriteRune(fili.thaan)
+				afterVowel = true
+				i += l
+				continue
+			}
+
+			fili, _ := match(vowelData, s[i:])
+			// "ey" followed by a vowel is "e" + yaa ("dheyey"), except before final "iy"
+			if fili.lat == "ey" && i+2 < n && isVowelByte(s[i+2]) &&
+				!(s[i+2] == 'i' && i+3 < n && s[i+3] == 'y' && atWordEnd(s, i+4)) {
+				fili, _ = match(vowelData, "e")
+			}
+
+			if hasPending {
+				b.WriteRune(pending.thaan)
+				hasPending = false
+			} else {
+				b.WriteRune(Alifu)
+			}
+			b.WriteRune(fili.thaan)
+			afterVowel = true
+			i += len(fili.lat)
+			continue
+		}
+
+		// --- Apostrophe before a vowel: Ainu carrier ("'aammu") ---
+		if c == '\'' && !hasPending && i+1 < n && isVowelByte(s[i+1]) {
+			fili, _ := match(vowelData, s[i+1:])
+			b.WriteRune(Ainu)
+			b.WriteRune(fili.thaan)
+			afterVowel = true
+			i += 1 + len(fili.lat)
+			continue
+		}
+
+		// --- Consonants ---
+		if isLetterByte(c) {
+			// Bare Noonu before a consonant ("kan'di", "an'bu")
+			if c == 'n' && i+1 < n && s[i+1] == '\'' {
+				flush()
+				b.WriteRune(Noonu)
+				afterVowel = false
+				i += 2
+				continue
+			}
+
+			cons, ok := match(consonantData, s[i:])
+			if !ok {
+				// Latin letter with no Thaana counterpart: pass through
+				flush()
+				b.WriteByte(c)
+				afterVowel = false
+				i++
+				continue
+			}
+
+			// Alifu + sukun: final "h" after a vowel ("baeh", "geh")
+			if cons.lat == "h" && afterVowel && !hasPending && atWordEnd(s, i+1) {
+				b.WriteRune(Alifu)
+				b.WriteRune(Sukun)
+				afterVowel = false
+				i++
+				continue
+			}
+
+			if hasPending {
+				switch {
+				case geminates(pending.lat, cons.lat) && (cons.lat == "n" || cons.lat == "m" && !arabic):
+					// Noonu + sukun before noonu/meemu ("annaaru", "mamma")
+					b.WriteRune(Noonu)
+					b.WriteRune(Sukun)
+				case geminates(pending.lat, cons.lat):
+					// Alifu + sukun geminates the next consonant ("bappa")
+					b.WriteRune(Alifu)
+					b.WriteRune(Sukun)
+				case pending.lat == "m" && (cons.lat == "b" || cons.lat == "p"):
+					// Noonu + sukun nasalizes to "m" before baa/paviyani ("ambara")
+					b.WriteRune(Noonu)
+					b.WriteRune(Sukun)
+				default:
+					flush()
+				}
+			}
+
+			pending = cons
+			hasPending = true
+			afterVowel = false
+			i += len(cons.lat)
+			continue
+		}
+
+		// --- Word boundary / non-Latin: pass through ---
+		flush()
+		afterVowel = false
+		inWord = false
+		if r, ok := nishaanData[c]; ok {
+			b.WriteRune(r)
+			i++
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		b.WriteString(s[i : i+size])
+		i += size
+	}
+
+	flush()
+	return b.String()
+}
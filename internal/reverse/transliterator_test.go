@@ -0,0 +1,71 @@
+package transliterator
+
+import "testing"
+
+func TestTransliteration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"alamaari", "އަލަމާރި"},
+		{"annaaru", "އަންނާރު"},
+		{"ethere", "އެތެރެ"},
+		{"edhuru", "އެދުރު"},
+		{"iskuru", "އިސްކުރު"},
+		{"reethi", "ރީތި"},
+		{"feyru", "ފޭރު"},
+		{"roanu", "ރޯނު"},
+		{"fai", "ފައި"},
+		{"muniavas", "މުނިއަވަސް"},
+		{"kiaashey", "ކިއާށޭ"},
+		{"raees", "ރައީސް"},
+		{"a'mal", "ޢަމަލް"},
+		{"a'ailaa", "ޢާއިލާ"},
+		{"mue'enu", "މުޢީނު"},
+		{"i'sh'q", "ޢިޝްޤް"},
+		{"maso'odh", "މަސްޢޫދް"},
+		{"u'nwaan", "ޢުންޥާން"},
+		{"nish'aan", "ނިޝާން"},
+		{"kh'al", "ޚަލް"},
+		{"bayyeh", "ބައްޔެއް"},
+		{"bappa", "ބައްޕަ"},
+		{"mamma", "މަންމަ"},
+		{"kan'di", "ކަނޑި"},
+		{"an'bu", "އަނބު"},
+		{"ambara", "އަންބަރަ"},
+		{"hamdhu", "ހަމްދު"},
+		{"faunu", "ފައުނު"},
+		{"baeh", "ބައެއް"},
+		{"olhu", "އޮޅު"},
+		{"oabaiy", "އޯބަތް"},
+		{"baiy", "ބަތް"},
+		{"hiiy", "ހިތް"},
+		{"eyiy", "އޭތް"},
+		{"fen", "ފެން"},
+		{"dheyey", "ދެޔޭ"},
+		{"muh'ammadhu", "މުޙައްމަދު"},
+		{"sh'aruthu", "ޝަރުތު"},
+		{"'aammu", "ޢާއްމު"},
+		{"miee jumlaekeve", "މިއީ ޖުމްލައެކެވެ"},
+		{"Dhivehi", "ދިވެހި"},
+		{"kihineh?", "ކިހިނެއް؟"},
+		{"1 vana", "1 ވަނަ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			result := Transliterate(tt.input)
+			if result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func BenchmarkTransliterate(b *testing.B) {
+	input := "dhivehi bas maale adhu boh ambara baeh geh sh'aruthu qaumu a'ammu"
+	for i := 0; i < b.N; i++ {
+		Transliterate(input)
+	}
+}
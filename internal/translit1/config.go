@@ -0,0 +1,31 @@
+package transliterator
+
+// Config supplies mapping entries for a Transliterator. Entries take
+// precedence over ConsonantMap and VowelMap; nil maps keep the defaults.
+type Config struct {
+	Consonants map[rune]string // akuru → Latin
+	Vowels     map[rune]string // fili → Latin
+}
+
+// Transliterator converts Thaana to Latin using its own lookup tables, built
+// once by New and never modified afterwards, so differently configured
+// Transliterators can be used concurrently.
+type Transliterator struct {
+	t *tables
+}
+
+// New returns a Transliterator whose tables are built from the package
+// defaults and cfg.
+func New(cfg Config) *Transliterator {
+	return &Transliterator{t: newTables(cfg)}
+}
+
+// Transliterate converts Dhivehi (Thaana) text to Latin.
+func (tr *Transliterator) Transliterate(input string) string {
+	return tr.t.transliterate(input, Options{})
+}
+
+// TransliterateWithOptions converts Dhivehi (Thaana) text to Latin with the given options.
+func (tr *Transliterator) TransliterateWithOptions(input string, opts Options) string {
+	return tr.t.transliterate(input, opts)
+}
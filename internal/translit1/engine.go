@@ -14,41 +14,58 @@ const (
 	thaanaSize      = 0x07B1 - 0x0780 // 49 slots: U+0780 through U+07B0
 )
 
-var (
+// tables holds the lookup arrays. Each Transliterator owns its tables; they
+// are never written after newTables.
+type tables struct {
 	cLat [thaanaSize]string
 	cOk  [thaanaSize]bool
 	vLat [thaanaSize]string
 	vOk  [thaanaSize]bool
-)
+}
+
+// std holds the tables built from ConsonantMap and VowelMap at init.
+var std *tables
 
 func init() {
-	for r, s := range ConsonantMap {
-		if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-			cLat[i] = s
-			cOk[i] = true
+	std = newTables(Config{})
+}
+
+// newTables builds lookup arrays from ConsonantMap and VowelMap, with the
+// entries of cfg taking precedence.
+func newTables(cfg Config) *tables {
+	t := new(tables)
+	for _, m := range []map[rune]string{ConsonantMap, cfg.Consonants} {
+		for r, s := range m {
+			if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
+				t.cLat[i] = s
+				t.cOk[i] = true
+			}
 		}
 	}
-	for r, s := range VowelMap {
-		if r == 'ް' {
-			continue // sukun handled separately
-		}
-		if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-			vLat[i] = s
-			vOk[i] = true
+	for _, m := range []map[rune]string{VowelMap, cfg.Vowels} {
+		for r, s := range m {
+			if r == 'ް' {
+				continue // sukun handled separately
+			}
+			if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
+				t.vLat[i] = s
+				t.vOk[i] = true
+			}
 		}
 	}
+	return t
 }
 
-func consonant(r rune) (string, bool) {
+func (t *tables) consonant(r rune) (string, bool) {
 	if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-		return cLat[i], cOk[i]
+		return t.cLat[i], t.cOk[i]
 	}
 	return "", false
 }
 
-func vowel(r rune) (string, bool) {
+func (t *tables) vowel(r rune) (string, bool) {
 	if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-		return vLat[i], vOk[i]
+		return t.vLat[i], t.vOk[i]
 	}
 	return "", false
 }
@@ -59,6 +76,10 @@ func Transliterate(input string) string {
 }
 
 func TransliterateWithOptions(input string, opts Options) string {
+	return std.transliterate(input, opts)
+}
+
+func (t *tables) transliterate(input string, opts Options) string {
 	runes := []rune(input)
 	n := len(runes)
 
@@ -110,7 +131,7 @@ func TransliterateWithOptions(input string, opts Options) string {
 				case lastRune == 'ނ' && (next == 'ބ' || next == 'ޕ'):
 					b.WriteByte('m')
 				case lastRune == 'އ':
-					if _, ok := consonant(next); ok {
+					if _, ok := t.consonant(next); ok {
 						geminateNext = true
 					} else {
 						b.WriteByte('h')
@@ -125,12 +146,12 @@ func TransliterateWithOptions(input string, opts Options) string {
 		}
 
 		// Vowels (fili)
-		if vl, ok := vowel(r); ok {
+		if vl, ok := t.vowel(r); ok {
 			if pending {
 				if lastRune == 'އ' {
 					switch {
 					case opts.SuppressGlottalStop:
-					case lastVowel != 0 && isDiphthong(lastVowel, r):
+					case lastVowel != 0 && t.isDiphthong(lastVowel, r):
 					case posInWord == 1:
 					default:
 						b.WriteByte('\'')
@@ -146,7 +167,7 @@ func TransliterateWithOptions(input string, opts Options) string {
 		}
 
 		// Consonants
-		if cl, ok := consonant(r); ok {
+		if cl, ok := t.consonant(r); ok {
 			if pending && lastLatin != "" {
 				b.WriteString(lastLatin)
 			}
@@ -187,14 +208,14 @@ func TransliterateWithOptions(input string, opts Options) string {
 	return b.String()
 }
 
-func isDiphthong(prev, curr rune) bool {
+func (t *tables) isDiphthong(prev, curr rune) bool {
 	pi := int(prev - thaanaBase)
 	ci := int(curr - thaanaBase)
 	if pi < 0 || pi >= thaanaSize || ci < 0 || ci >= thaanaSize {
 		return false
 	}
-	p := vLat[pi]
-	c := vLat[ci]
+	p := t.vLat[pi]
+	c := t.vLat[ci]
 	if len(p) == 0 || len(c) == 0 {
 		return false
 	}
@@ -139,4 +139,38 @@ func BenchmarkTransliterateWithOptions(b *testing.B) {
 }
 
 // (from the internal/transliterator/ directory)
-// go test -bench Benchmark -benchmem
\ No newline at end of file
+// go test -bench Benchmark -benchmem
+
+func TestNew(t *testing.T) {
+    houseStyle := New(Config{
+        Consonants: map[rune]string{'ދ': "d", 'ތ': "t"},
+        Vowels:     map[rune]string{'ޯ': "o"},
+    })
+    plain := New(Config{})
+
+    tests := []struct {
+        tr       *Transliterator
+        input    string
+        expected string
+    }{
+        {houseStyle, "ދިވެހި", "divehi"},
+        {houseStyle, "ރޯނު", "ronu"},
+        {plain, "ދިވެހި", "dhivehi"},
+        {plain, "ރޯނު", "roanu"},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.input, func(t *testing.T) {
+            t.Parallel()
+            for i := 0; i < 100; i++ {
+                if result := tt.tr.Transliterate(tt.input); result != tt.expected {
+                    t.Fatalf("got %q, want %q", result, tt.expected)
+                }
+            }
+        })
+    }
+
+    if result := Transliterate("ދިވެހި"); result != "dhivehi" {
+        t.Errorf("package default: got %q, want %q", result, "dhivehi")
+    }
+}
@@ -0,0 +1,55 @@
+package transliterator
+
+// Config supplies mapping entries for a Transliterator. Entries take
+// precedence over the exported package maps; nil maps keep the defaults.
+type Config struct {
+	Akuru           map[rune]string
+	AkuruNormalized map[rune]string
+	Fili            map[rune]string
+	SukunOverrides  map[rune]string
+	AkuruNames      map[rune]string
+	Nishaan         map[rune]rune
+}
+
+// Transliterator converts Thaana to Latin using private copies of the
+// mapping tables, taken by New and never modified afterwards. Later edits to
+// the exported package maps do not affect it, and differently configured
+// Transliterators can be used concurrently.
+type Transliterator struct {
+	t *tables
+}
+
+// New returns a Transliterator whose tables are copied from the exported
+// package maps and cfg.
+func New(cfg Config) *Transliterator {
+	return &Transliterator{t: &tables{
+		akuru:           merge(Akuru, cfg.Akuru),
+		akuruNormalized: merge(AkuruNormalized, cfg.AkuruNormalized),
+		fili:            merge(Fili, cfg.Fili),
+		sukunOverrides:  merge(SukunOverrides, cfg.SukunOverrides),
+		akuruNames:      merge(AkuruNames, cfg.AkuruNames),
+		nishaan:         merge(Nishaan, cfg.Nishaan),
+	}}
+}
+
+// Transliterate converts Dhivehi (Thaana) text to Latin with default options.
+func (tr *Transliterator) Transliterate(input string) string {
+	return tr.t.transliterate(input, Options{})
+}
+
+// TransliterateWithOptions converts Dhivehi (Thaana) text to Latin with the given options.
+func (tr *Transliterator) TransliterateWithOptions(input string, opts Options) string {
+	return tr.t.transliterate(input, opts)
+}
+
+// merge returns a new map holding base overlaid with over.
+func merge[V any](base, over map[rune]V) map[rune]V {
+	m := make(map[rune]V, len(base)+len(over))
+	for r, v := range base {
+		m[r] = v
+	}
+	for r, v := range over {
+		m[r] = v
+	}
+	return m
+}
@@ -49,6 +49,19 @@ var Akuru = map[rune]string{
 	'\u07A5': "w",
 }
 
+// AkuruNormalized overrides Akuru for Arabic-derived letters when
+// Options.NormalizeArabic is set, collapsing them to standard Latin.
+var AkuruNormalized = map[rune]string{
+	'\u0799': "h",
+	'\u079B': "dh",
+	'\u079D': "sh",
+	'\u079E': "s",
+	'\u079F': "d",
+	'\u07A0': "th",
+	'\u07A1': "z",
+	'\u0798': "th",
+}
+
 var Fili = map[rune]string{
 	'\u07A6': "a",
 	'\u07A7': "aa",
@@ -78,14 +91,14 @@ var AkuruNames = map[rune]string{
 	'\u0786': "kaafu",
 	'\u0787': "alifu",
 	'\u0788': "vaavu",
-	'\u0789': "meymu",
+	'\u0789': "meemu",
 	'\u078A': "faafu",
 	'\u078B': "dhaalu",
 	'\u078C': "thaalu",
 	'\u078D': "laamu",
 	'\u078E': "gaafu",
-	'\u078F': "nyaviyani",
-	'\u0790': "seynu",
+	'\u078F': "gnaviyani",
+	'\u0790': "seenu",
 	'\u0791': "daviyani",
 	'\u0792': "zaviyani",
 	'\u0793': "taviyani",
@@ -94,7 +107,7 @@ var AkuruNames = map[rune]string{
 	'\u0796': "javiyani",
 	'\u0797': "chaviyani",
 
-	'\u0799': "haa",
+	'\u0799': "hhaa",
 	'\u079A': "khaa",
 	'\u079B': "zhaalu",
 	'\u079C': "zaa",
@@ -106,8 +119,8 @@ var AkuruNames = map[rune]string{
 	'\u0798': "tsaa",
 	'\u07A2': "ainu",
 	'\u07A3': "ghainu",
-	'\u07A4': "gaafu",
-	'\u07A5': "vaavu",
+	'\u07A4': "qaafu",
+	'\u07A5': "waavu",
 }
 
 var Nishaan = map[rune]rune{
@@ -4,7 +4,58 @@ import (
 	"strings"
 )
 
+// Options configures transliteration features, with the same semantics as
+// translit3.Options plus control over bare-akuru letter names.
+type Options struct {
+	Gemination          bool // consonant + sukun + same consonant → doubled output
+	SuppressGlottalStop bool // suppress apostrophe between adjacent vowels (no effect: no glottal stop is emitted)
+	NormalizeArabic     bool // collapse Arabic-derived letters to standard Latin via AkuruNormalized
+	NoAkuruNames        bool // bare akuru → Latin consonant instead of its AkuruNames entry
+}
+
+// tables holds the mapping tables used by the engine.
+type tables struct {
+	akuru           map[rune]string
+	akuruNormalized map[rune]string
+	fili            map[rune]string
+	sukunOverrides  map[rune]string
+	akuruNames      map[rune]string
+	nishaan         map[rune]rune
+}
+
+// std reads the exported package maps directly, so edits to them are seen
+// by the package-level functions.
+var std = &tables{
+	akuru:           Akuru,
+	akuruNormalized: AkuruNormalized,
+	fili:            Fili,
+	sukunOverrides:  SukunOverrides,
+	akuruNames:      AkuruNames,
+	nishaan:         Nishaan,
+}
+
+// akuruOf looks up a consonant, applying akuruNormalized when requested.
+func (t *tables) akuruOf(r rune, opts Options) (string, bool) {
+	if opts.NormalizeArabic {
+		if s, ok := t.akuruNormalized[r]; ok {
+			return s, true
+		}
+	}
+	s, ok := t.akuru[r]
+	return s, ok
+}
+
+// Transliterate converts Dhivehi (Thaana) text to Latin with default options.
 func Transliterate(input string) string {
+	return TransliterateWithOptions(input, Options{})
+}
+
+// TransliterateWithOptions converts Dhivehi (Thaana) text to Latin with the given options.
+func TransliterateWithOptions(input string, opts Options) string {
+	return std.transliterate(input, opts)
+}
+
+func (t *tables) transliterate(input string, opts Options) string {
 	runes := []rune(input)
 	var result strings.Builder
 
@@ -14,11 +65,11 @@ func Transliterate(input string) string {
 	for i < len(runes) {
 		r := runes[i]
 
-		if akuru, ok := Akuru[r]; ok {
+		if lat, ok := t.akuruOf(r, opts); ok {
 			if i+1 < len(runes) {
 				next := runes[i+1]
 
-				if fili, ok := Fili[next]; ok {
+				if fili, ok := t.fili[next]; ok {
 					if r == Ainu {
 
 						filiRunes := []rune(fili)
@@ -28,7 +79,7 @@ func Transliterate(input string) string {
 							result.WriteString(string(filiRunes[1:]))
 						}
 					} else {
-						result.WriteString(akuru)
+						result.WriteString(lat)
 						result.WriteString(fili)
 					}
 					i += 2
@@ -36,10 +87,15 @@ func Transliterate(input string) string {
 				}
 
 				if next == Sukun {
+					// Gemination: akuru + sukun + same akuru → doubled
+					if opts.Gemination && i+2 < len(runes) && runes[i+2] == r {
+						result.WriteString(lat)
+					}
+
 					if r == Alifu || r == Shaviyani {
 
 						if i+2 < len(runes) {
-							if nextAkuru, isAkuru := Akuru[runes[i+2]]; isAkuru && len(nextAkuru) > 0 {
+							if nextAkuru, isAkuru := t.akuruOf(runes[i+2], opts); isAkuru && len(nextAkuru) > 0 {
 								result.WriteByte(nextAkuru[0])
 								i += 2
 								continue
@@ -56,7 +112,7 @@ func Transliterate(input string) string {
 						if i+2 < len(runes) {
 							nextR := runes[i+2]
 							if nextR == '\u0789' || nextR == '\u0784' || nextR == '\u0795' {
-								if nextAkuru, isAkuru := Akuru[nextR]; isAkuru {
+								if nextAkuru, isAkuru := t.akuruOf(nextR, opts); isAkuru {
 									result.WriteByte(nextAkuru[0])
 									i += 2
 									continue
@@ -64,15 +120,15 @@ func Transliterate(input string) string {
 							}
 						}
 
-						result.WriteString(akuru)
+						result.WriteString(lat)
 						i += 2
 						continue
 					}
 
-					if override, ok := SukunOverrides[r]; ok {
+					if override, ok := t.sukunOverrides[r]; ok {
 						result.WriteString(override)
 					} else {
-						result.WriteString(akuru)
+						result.WriteString(lat)
 					}
 					i += 2
 					continue
@@ -80,8 +136,8 @@ func Transliterate(input string) string {
 			}
 
 			if r == Noonu && i != 0 && i < len(runes)-1 {
-				_, ok1 := Fili[runes[i-1]]
-				_, ok2 := Akuru[runes[i+1]]
+				_, ok1 := t.fili[runes[i-1]]
+				_, ok2 := t.akuru[runes[i+1]]
 				if ok1 && ok2 {
 					result.WriteString("n'")
 					i++
@@ -93,12 +149,12 @@ func Transliterate(input string) string {
 				afterFiliOrAkuru := false
 				beforeAkuru := false
 				if i > 0 {
-					_, pf := Fili[runes[i-1]]
-					_, pa := Akuru[runes[i-1]]
+					_, pf := t.fili[runes[i-1]]
+					_, pa := t.akuru[runes[i-1]]
 					afterFiliOrAkuru = pf || pa
 				}
 				if i+1 < len(runes) {
-					_, na := Akuru[runes[i+1]]
+					_, na := t.akuru[runes[i+1]]
 					beforeAkuru = na
 				}
 				if afterFiliOrAkuru || beforeAkuru {
@@ -108,7 +164,9 @@ func Transliterate(input string) string {
 				}
 			}
 
-			if name, ok := AkuruNames[r]; ok {
+			if opts.NoAkuruNames {
+				result.WriteString(lat)
+			} else if name, ok := t.akuruNames[r]; ok {
 				result.WriteString(name)
 			}
 
@@ -116,7 +174,7 @@ func Transliterate(input string) string {
 			continue
 		}
 
-		if nishaan, ok := Nishaan[r]; ok {
+		if nishaan, ok := t.nishaan[r]; ok {
 			result.WriteRune(nishaan)
 			i++
 			continue
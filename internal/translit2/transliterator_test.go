@@ -99,3 +99,76 @@ func TestTransliteration(t *testing.T) {
 	}
 
 }
+
+func TestTransliterateWithOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     Options
+		input    string
+		expected string
+	}{
+		{"Default", Options{}, "ބ ޏ", "baa gnaviyani"},
+		{"Gemination", Options{Gemination: true}, "ބައްބަ", "babba"},
+		{"Gemination", Options{Gemination: true}, "ބަބްބަ", "babbba"},
+		{"SuppressGlottalStop", Options{SuppressGlottalStop: true}, "ބައެއް", "baeh"},
+		{"NormalizeArabic", Options{NormalizeArabic: true}, "ޝަރުޠު", "sharuthu"},
+		{"NormalizeArabic", Options{NormalizeArabic: true}, "މުޙައްމަދު", "muhammadhu"},
+		{"NoAkuruNames", Options{NoAkuruNames: true}, "ބ ޏ", "b gn"},
+		{"NoAkuruNames", Options{NoAkuruNames: true}, "ދިވެހި", "dhivehi"},
+		{"Combined", Options{Gemination: true, NormalizeArabic: true, NoAkuruNames: true}, "ޝަބްބަ ޙ", "shabbba h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.input, func(t *testing.T) {
+			result := TransliterateWithOptions(tt.input, tt.opts)
+			if result != tt.expected {
+				t.Errorf("TransliterateWithOptions(%q, %+v) = %q, want %q",
+					tt.input, tt.opts, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	houseStyle := New(Config{
+		Akuru:      map[rune]string{'ދ': "d", 'ތ': "t"},
+		AkuruNames: map[rune]string{'މ': "meem"},
+	})
+	plain := New(Config{})
+
+	tests := []struct {
+		tr       *Transliterator
+		input    string
+		expected string
+	}{
+		{houseStyle, "ދިވެހި", "divehi"},
+		{houseStyle, "މ", "meem"},
+		{plain, "ދިވެހި", "dhivehi"},
+		{plain, "މ", "meemu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			for i := 0; i < 100; i++ {
+				if result := tt.tr.Transliterate(tt.input); result != tt.expected {
+					t.Fatalf("got %q, want %q", result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNewCopiesTables(t *testing.T) {
+	tr := New(Config{})
+	orig := Akuru['ދ']
+	Akuru['ދ'] = "d"
+	defer func() { Akuru['ދ'] = orig }()
+
+	if result := tr.Transliterate("ދިވެހި"); result != "dhivehi" {
+		t.Errorf("instance: got %q, want %q", result, "dhivehi")
+	}
+	if result := Transliterate("ދިވެހި"); result != "divehi" {
+		t.Errorf("package maps: got %q, want %q", result, "divehi")
+	}
+}
@@ -0,0 +1,106 @@
+package transliterator
+
+// ALA-LC mode follows the ALA-LC Divehi romanization table used in library
+// catalogues: macrons for long vowels, underdots for the retroflexes and
+// ALA-LC Arabic/Urdu values for Arabic-derived letters. Letters are written
+// one for one, so the Malé Latin "n'" and "m" Noonu rules and the Ainu
+// reordering are off; the Alifu and Shaviyani sukun rules still apply.
+
+// alalc holds the tables used by TransliterateALALC.
+var alalc = newALALCTables()
+
+var alalcConsonantData = map[rune]string{
+	'ހ': "h",
+	'ށ': "sh",
+	'ނ': "n",
+	'ރ': "r",
+	'ބ': "b",
+	'ޅ': "ḷ",
+	'ކ': "k",
+	'އ': "",
+	'ވ': "v",
+	'މ': "m",
+	'ފ': "f",
+	'ދ': "d",
+	'ތ': "t",
+	'ލ': "l",
+	'ގ': "g",
+	'ޏ': "ñ",
+	'ސ': "s",
+	'ޑ': "ḍ",
+	'ޒ': "z",
+	'ޓ': "ṭ",
+	'ޔ': "y",
+	'ޕ': "p",
+	'ޖ': "j",
+	'ޗ': "ch",
+}
+
+// Arabic-derived letters. Where the Arabic table's underdot would collide
+// with a Thaana retroflex (ض, ط, ظ), the Urdu table's values are used.
+var alalcArabicData = map[rune]string{
+	'ޘ': "th",
+	'ޙ': "ḥ",
+	'ޚ': "kh",
+	'ޛ': "dh",
+	'ޝ': "sh",
+	'ޞ': "ṣ",
+	'ޟ': "ż",
+	'ޠ': "t̤",
+	'ޡ': "z̤",
+	'ޢ': "ʻ",
+	'ޣ': "gh",
+	'ޤ': "q",
+	'ޥ': "w",
+}
+
+var alalcVowelData = map[rune]string{
+	'ަ': "a",
+	'ާ': "ā",
+	'ި': "i",
+	'ީ': "ī",
+	'ު': "u",
+	'ޫ': "ū",
+	'ެ': "e",
+	'ޭ': "ē",
+	'ޮ': "o",
+	'ޯ': "ō",
+}
+
+var alalcSukunOverrideData = map[rune]string{
+	'ތ': "t",
+	'ޏ': "ñ",
+	'ޢ': "ʻ",
+}
+
+// newALALCTables builds the ALA-LC tables. Arabic-derived letters keep their
+// ALA-LC values under NormalizeArabic too, so no Malé Latin leaks in.
+func newALALCTables() *tables {
+	consonants := make(map[rune]string, len(alalcConsonantData)+len(alalcArabicData))
+	for _, m := range []map[rune]string{alalcConsonantData, alalcArabicData} {
+		for r, s := range m {
+			consonants[r] = s
+		}
+	}
+	t := newTables(Config{
+		Consonants:           consonants,
+		NormalizedConsonants: alalcArabicData,
+		Vowels:               alalcVowelData,
+		SukunOverrides:       alalcSukunOverrideData,
+	})
+	t.literal = true
+	return t
+}
+
+// TransliterateALALC converts Dhivehi (Thaana) text to ALA-LC romanization
+// with default options.
+func TransliterateALALC(input string) string {
+	return alalc.transliterate(input, Options{}, nil)
+}
+
+// TransliterateALALCWithOptions converts Dhivehi (Thaana) text to ALA-LC
+// romanization. Gemination behaves as in the Latin engine; NormalizeArabic
+// and SuppressGlottalStop have no effect.
+func TransliterateALALCWithOptions(input string, opts Options) string {
+	return alalc.transliterate(input, opts, nil)
+}
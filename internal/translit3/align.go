@@ -0,0 +1,43 @@
+package transliterator
+
+// Segment maps an input byte range to the output byte range it produced.
+// Multi-rune rules (Alifu + sukun gemination, Ainu + fili, Noonu "n'")
+// yield a single segment covering every rune involved.
+type Segment struct {
+	InStart, InEnd   int
+	OutStart, OutEnd int
+}
+
+// aligner records segment boundaries while the engine writes output.
+type aligner struct {
+	offs []int // byte offset of each rune index, plus len(input)
+	segs []Segment
+	in   int
+	out  int
+}
+
+func newAligner(input string) *aligner {
+	offs := make([]int, 0, len(input)+1)
+	for i := range input {
+		offs = append(offs, i)
+	}
+	offs = append(offs, len(input))
+	return &aligner{offs: offs}
+}
+
+// mark closes the current segment at rune index i and output length out.
+func (a *aligner) mark(i, out int) {
+	in := a.offs[i]
+	if in > a.in || out > a.out {
+		a.segs = append(a.segs, Segment{a.in, in, a.out, out})
+		a.in, a.out = in, out
+	}
+}
+
+// TransliterateAligned is like TransliterateWithOptions but also returns the
+// alignment between input and output byte ranges, in order.
+func TransliterateAligned(input string, opts Options) (string, []Segment) {
+	al := newAligner(input)
+	out := std.transliterate(input, opts, al)
+	return out, al.segs
+}
@@ -0,0 +1,56 @@
+package transliterator
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Collation weights, one byte per rune (three more for runes outside
+// Thaana). A word sorts syllable by syllable: a consonant with sukun before
+// the same consonant with any fili, and fili in abafili…oaboafili order.
+// Consonants follow the traditional order haa, shaviyani, noonu, raa…
+// chaviyani, which is also their order in the Thaana block, and the
+// Arabic-derived letters come after them as in the Radheef.
+const (
+	weightSpace     = 0x01
+	weightDigit     = 0x02 // '0'..'9' → 0x02..0x0B
+	weightSukun     = 0x10
+	weightFili      = 0x11 // abafili..oaboafili → 0x11..0x1A
+	weightConsonant = 0x20 // haa..waavu → 0x20..0x45
+	weightOther     = 0xF0 // followed by the rune, big-endian
+)
+
+// SortKey returns a key for s whose byte order is Thaana alphabetical order.
+// Spaces sort first, then digits, then Thaana; other runes sort after
+// Thaana by code point.
+func SortKey(s string) []byte {
+	key := make([]byte, 0, len(s))
+	for _, r := range s {
+		i := int(r - thaanaBase)
+		switch {
+		case r == Sukun:
+			key = append(key, weightSukun)
+		case i >= 0 && i < thaanaSize && std.vOk[i]:
+			key = append(key, weightFili+byte(r-'ަ'))
+		case i >= 0 && i < thaanaSize && std.akNamesOk[i]:
+			key = append(key, weightConsonant+byte(i))
+		case r == ' ' || r == '\t' || r == '\n':
+			key = append(key, weightSpace)
+		case r >= '0' && r <= '9':
+			key = append(key, weightDigit+byte(r-'0'))
+		default:
+			key = append(key, weightOther, byte(r>>16), byte(r>>8), byte(r))
+		}
+	}
+	return key
+}
+
+// Compare returns -1, 0 or +1 as a sorts before, equal to or after b in
+// Thaana alphabetical order. Strings with equal keys compare by bytes, so
+// the order is total.
+func Compare(a, b string) int {
+	if c := bytes.Compare(SortKey(a), SortKey(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
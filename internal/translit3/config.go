@@ -0,0 +1,46 @@
+package transliterator
+
+// Config supplies mapping entries for a Transliterator. Entries take
+// precedence over the package defaults in mappings.go; nil maps keep the
+// defaults. Keys outside the Thaana block (Arabic block for Nishaan) are
+// ignored.
+type Config struct {
+	Consonants           map[rune]string   // akuru → Latin
+	NormalizedConsonants map[rune]string   // akuru → Latin when Options.NormalizeArabic is set
+	Vowels               map[rune]string   // fili → Latin
+	SukunOverrides       map[rune]string   // akuru + sukun → Latin
+	AkuruNames           map[rune]string   // akuru → letter name
+	Nishaan              map[rune]rune     // punctuation → Latin punctuation
+	Names                map[string]string // Thaana name → Latin spelling for Options.PersonNames
+}
+
+// Transliterator converts Thaana to Latin using its own lookup tables, built
+// once by New and never modified afterwards. Differently configured
+// Transliterators can therefore be used concurrently in one process.
+type Transliterator struct {
+	t *tables
+}
+
+// New returns a Transliterator whose tables are built from the package
+// defaults and cfg. The maps in cfg are copied and may be reused by the caller.
+func New(cfg Config) *Transliterator {
+	return &Transliterator{t: newTables(cfg)}
+}
+
+// Transliterate converts Dhivehi (Thaana) text to Latin with default options.
+func (tr *Transliterator) Transliterate(input string) string {
+	return tr.t.transliterate(input, Options{}, nil)
+}
+
+// TransliterateWithOptions converts Dhivehi (Thaana) text to Latin with the given options.
+func (tr *Transliterator) TransliterateWithOptions(input string, opts Options) string {
+	return tr.t.transliterate(input, opts, nil)
+}
+
+// TransliterateAligned is like TransliterateWithOptions but also returns the
+// alignment between input and output byte ranges, in order.
+func (tr *Transliterator) TransliterateAligned(input string, opts Options) (string, []Segment) {
+	al := newAligner(input)
+	out := tr.t.transliterate(input, opts, al)
+	return out, al.segs
+}
@@ -0,0 +1,58 @@
+package transliterator
+
+import "maps"
+
+// The southern profile is for Addu and Fuvahmulah material. Its one
+// dialect-specific entry is NAA (ޱ, U+07B1), the retroflex n of southern
+// writing, written "nh"; the default tables leave it untransliterated, as
+// the other engines do. The rest are spelling choices of the profile, not
+// dialect rules: the Arabic-derived zaa (ޜ), which the default tables also
+// leave out, is written "z", long vowels take a macron (ā ī ū ē ō), and a
+// letter with sukun keeps its own consonant (ތް "t", ށް "sh", ޏް "gn")
+// instead of the Malé "iy" and "h". Alifu + sukun still geminates the next
+// letter.
+
+// dialect holds the tables used by TransliterateDialect.
+var dialect = newTables(DialectConfig())
+
+var dialectConsonantData = map[rune]string{
+	'ޜ': "z",
+	'ޱ': "nh",
+}
+
+var dialectVowelData = map[rune]string{
+	'ާ': "ā",
+	'ީ': "ī",
+	'ޫ': "ū",
+	'ޭ': "ē",
+	'ޯ': "ō",
+}
+
+var dialectSukunOverrideData = map[rune]string{
+	'ތ': "t",
+	'ށ': "sh",
+	'ޏ': "gn",
+	'ޱ': "nh",
+}
+
+// DialectConfig returns the southern dialect profile as a Config, to be
+// passed to New as is or with entries changed. Each call returns new maps.
+func DialectConfig() Config {
+	return Config{
+		Consonants:     maps.Clone(dialectConsonantData),
+		Vowels:         maps.Clone(dialectVowelData),
+		SukunOverrides: maps.Clone(dialectSukunOverrideData),
+	}
+}
+
+// TransliterateDialect converts southern-dialect Dhivehi (Thaana) text to
+// Latin with default options.
+func TransliterateDialect(input string) string {
+	return dialect.transliterate(input, Options{}, nil)
+}
+
+// TransliterateDialectWithOptions converts southern-dialect Dhivehi (Thaana)
+// text to Latin with the given options.
+func TransliterateDialectWithOptions(input string, opts Options) string {
+	return dialect.transliterate(input, opts, nil)
+}
@@ -1,57 +1,61 @@
 package transliterator
 
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 // Options configures transliteration features.
 type Options struct {
 	Gemination          bool // consonant + sukun + same consonant → doubled output
 	SuppressGlottalStop bool // suppress apostrophe between adjacent vowels (no effect in default mode)
 	NormalizeArabic     bool // collapse Arabic-derived letters to standard Latin (V1 style)
+	PersonNames         bool // whole words in the name lexicon → their conventional spelling
 }
 
 // Array accessor helpers — inlined by the compiler.
 
-func consonant(r rune, norm bool) (string, bool) {
+func (t *tables) consonant(r rune, norm bool) (string, bool) {
 	if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
 		if norm {
-			return cLatNorm[i], cOk[i]
+			return t.cLatNorm[i], t.cOk[i]
 		}
-		return cLat[i], cOk[i]
+		return t.cLat[i], t.cOk[i]
 	}
 	return "", false
 }
 
-func vowel(r rune) (string, bool) {
+func (t *tables) vowel(r rune) (string, bool) {
 	if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-		return vLat[i], vOk[i]
+		return t.vLat[i], t.vOk[i]
 	}
 	return "", false
 }
 
-func sukunOverride(r rune) (string, bool) {
+func (t *tables) sukunOverride(r rune) (string, bool) {
 	if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-		return skOver[i], skOverOk[i]
+		return t.skOver[i], t.skOverOk[i]
 	}
 	return "", false
 }
 
-func nishaan(r rune) (rune, bool) {
-	if int(r) < len(nishaanLat) {
-		return nishaanLat[r], nishaanOk[r]
+func (t *tables) nishaan(r rune) (rune, bool) {
+	if i := int(r - nishaanBase); i >= 0 && i < nishaanSize {
+		return t.nishaanLat[i], t.nishaanOk[i]
 	}
 	return 0, false
 }
 
-func isConsonant(r rune) bool {
+func (t *tables) isConsonant(r rune) bool {
 	if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-		return cOk[i]
+		return t.cOk[i]
 	}
 	return false
 }
 
-func isVowel(r rune) bool {
+func (t *tables) isVowel(r rune) bool {
 	if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-		return vOk[i]
+		return t.vOk[i]
 	}
 	return false
 }
@@ -63,6 +67,11 @@ func Transliterate(input string) string {
 
 // TransliterateWithOptions converts Dhivehi (Thaana) text to Latin with the given options.
 func TransliterateWithOptions(input string, opts Options) string {
+	return std.transliterate(input, opts, nil)
+}
+
+// transliterate is the engine proper; al, when non-nil, records alignment.
+func (t *tables) transliterate(input string, opts Options, al *aligner) string {
 	runes := []rune(input)
 	n := len(runes)
 
@@ -75,7 +84,6 @@ func TransliterateWithOptions(input string, opts Options) string {
 		lastRune     rune
 		lastLatin    string
 		pending      bool
-		posInWord    int
 		geminateNext bool
 	)
 
@@ -87,25 +95,50 @@ func TransliterateWithOptions(input string, opts Options) string {
 			next = runes[i+1]
 		}
 
+		if al != nil && !pending && !geminateNext {
+			al.mark(i, b.Len())
+		}
+
 		// --- Whitespace: word boundary reset ---
 		if r <= ' ' && (r == ' ' || r == '\n' || r == '\t') {
 			if pending && lastLatin != "" {
 				b.WriteString(lastLatin)
 			}
+			if al != nil {
+				al.mark(i, b.Len())
+			}
 			b.WriteRune(r)
 			lastRune = 0
 			lastLatin = ""
 			pending = false
-			posInWord = 0
 			geminateNext = false
 			continue
 		}
 
+		// --- Person names: a whole word found in the name lexicon, whose
+		// spellings are orthographic and so not used by IPA or ALA-LC ---
+		if opts.PersonNames && !t.phonetic && !t.literal && !pending && isThaana(r) && (i == 0 || !isThaana(runes[i-1])) {
+			j := i + 1
+			for j < n && isThaana(runes[j]) {
+				j++
+			}
+			if lat, ok := t.names[string(runes[i:j])]; ok {
+				b.WriteString(lat)
+				lastRune = 0
+				lastLatin = ""
+				i = j - 1
+				continue
+			}
+		}
+
 		// --- Punctuation (Nishaan) ---
-		if lat, ok := nishaan(r); ok {
+		if lat, ok := t.nishaan(r); ok {
 			if pending && lastLatin != "" {
 				b.WriteString(lastLatin)
 			}
+			if al != nil {
+				al.mark(i, b.Len())
+			}
 			pending = false
 			b.WriteRune(lat)
 			continue
@@ -114,13 +147,21 @@ func TransliterateWithOptions(input string, opts Options) string {
 		// --- Sukun ---
 		if r == Sukun {
 			if pending {
+				// IPA: consonant + sukun + same consonant → one long consonant
+				if t.phonetic && i+1 < n && next == lastRune {
+					geminateNext = true
+					pending = false
+					lastLatin = ""
+					continue
+				}
+
 				// Gemination: consonant + sukun + same consonant → doubled
 				if opts.Gemination && i+1 < n && runes[i+1] == lastRune {
 					b.WriteString(lastLatin)
 				}
 
 				// SukunOverride check (thaalu→"iy", ainu→"u", nyaviyani→"")
-				if override, ok := sukunOverride(lastRune); ok {
+				if override, ok := t.sukunOverride(lastRune); ok {
 					b.WriteString(override)
 					pending = false
 					lastLatin = ""
@@ -130,9 +171,15 @@ func TransliterateWithOptions(input string, opts Options) string {
 				switch {
 				case lastRune == Shaviyani:
 					// Shaviyani + sukun: if next consonant exists, output its first byte; else "h"
-					if i+1 < n {
-						if cl, ok := consonant(next, norm); ok && len(cl) > 0 {
-							b.WriteByte(cl[0])
+					if t.phonetic {
+						if t.isConsonant(next) {
+							geminateNext = true
+						} else {
+							b.WriteString("ʔ")
+						}
+					} else if i+1 < n {
+						if cl, ok := t.consonant(next, norm); ok && len(cl) > 0 {
+							b.WriteString(firstLetter(cl))
 						} else {
 							b.WriteByte('h')
 						}
@@ -142,17 +189,21 @@ func TransliterateWithOptions(input string, opts Options) string {
 
 				case lastRune == Alifu:
 					// Alifu + sukun: if next is a consonant, geminate; else "h"
-					if cl, ok := consonant(next, norm); ok && len(cl) > 0 {
+					if cl, ok := t.consonant(next, norm); ok && len(cl) > 0 {
 						geminateNext = true
+					} else if t.phonetic {
+						b.WriteString("ʔ")
 					} else {
 						b.WriteByte('h')
 					}
 
 				case lastRune == Noonu:
 					// Noonu + sukun: nasalization before meemu/baa/paviyani
-					if i+1 < n && (next == Meemu || next == Baa || next == Paviyani) {
-						if cl, ok := consonant(next, norm); ok {
-							b.WriteByte(cl[0])
+					if t.phonetic {
+						b.WriteString(nasal(next, t.isConsonant(next)))
+					} else if !t.literal && i+1 < n && (next == Meemu || next == Baa || next == Paviyani) {
+						if cl, ok := t.consonant(next, norm); ok {
+							b.WriteString(firstLetter(cl))
 						} else {
 							b.WriteString(lastLatin)
 						}
@@ -170,7 +221,7 @@ func TransliterateWithOptions(input string, opts Options) string {
 		}
 
 		// --- Vowels (Fili) ---
-		if vl, ok := vowel(r); ok {
+		if vl, ok := t.vowel(r); ok {
 			if pending {
 				if lastRune == Alifu {
 					// Alifu is a silent carrier — no glottal stop (V2 accuracy)
@@ -184,18 +235,29 @@ func TransliterateWithOptions(input string, opts Options) string {
 		}
 
 		// --- Consonants ---
-		if cl, ok := consonant(r, norm); ok {
+		if cl, ok := t.consonant(r, norm); ok {
 			// Flush pending consonant
 			if pending && lastLatin != "" {
 				b.WriteString(lastLatin)
 			}
+			if al != nil && !geminateNext {
+				al.mark(i, b.Len())
+			}
 			pending = false
 
 			lat := cl
 
-			// Ainu + fili: output first char of fili, then apostrophe, then rest (V2 rule)
+			// Ainu + fili: output first char of fili, then apostrophe, then rest (V2 rule).
+			// IPA and ALA-LC write Ainu's own value before the fili instead.
 			if r == Ainu && i+1 < n {
-				if vl, vOk := vowel(next); vOk {
+				if vl, vOk := t.vowel(next); vOk && (t.phonetic || t.literal) {
+					b.WriteString(cl)
+					b.WriteString(vl)
+					lastRune = r
+					lastLatin = ""
+					i++
+					continue
+				} else if vOk {
 					filiRunes := []rune(vl)
 					b.WriteRune(filiRunes[0])
 					b.WriteByte('\'')
@@ -204,38 +266,46 @@ func TransliterateWithOptions(input string, opts Options) string {
 					}
 					lastRune = r
 					lastLatin = ""
-					posInWord++
 					i++ // skip the vowel; loop's own i++ advances past Ainu
 					continue
 				}
 			}
 
 			// Noonu between fili and next consonant → "n'" (V2 syllable boundary)
-			if r == Noonu && i > 0 && i < n-1 {
-				if isVowel(runes[i-1]) && isConsonant(runes[i+1]) {
-					b.WriteString("n'")
+			if r == Noonu && !t.literal && i > 0 && i < n-1 {
+				if t.isVowel(runes[i-1]) && t.isConsonant(runes[i+1]) {
+					if t.phonetic {
+						b.WriteString(prenasal(next))
+					} else {
+						b.WriteString("n'")
+					}
 					lastRune = r
 					lastLatin = ""
-					posInWord++
 					continue // loop's i++ advances past noonu; next consonant processed normally
 				}
 			}
 
-			// Noonu before baa/paviyani → nasalize to "m"
-			if r == Noonu && (next == Baa || next == Paviyani) {
+			// Noonu before baa/paviyani → nasalize to "m" (IPA: prenasalized)
+			if r == Noonu && !t.literal && (next == Baa || next == Paviyani) {
 				lat = "m"
+				if t.phonetic {
+					lat = prenasal(next)
+				}
 			}
 
-			// Apply gemination from alifu+sukun
+			// Apply gemination from alifu+sukun (IPA: length mark instead)
 			if geminateNext {
-				b.WriteString(lat)
+				if !t.phonetic {
+					b.WriteString(lat)
+				} else if lat != "" {
+					lat += "ː"
+				}
 				geminateNext = false
 			}
 
 			lastRune = r
 			lastLatin = lat
 			pending = true
-			posInWord++
 			continue
 		}
 
@@ -243,33 +313,38 @@ func TransliterateWithOptions(input string, opts Options) string {
 		if pending && lastLatin != "" {
 			b.WriteString(lastLatin)
 		}
+		if al != nil {
+			al.mark(i, b.Len())
+		}
 		pending = false
 		b.WriteRune(r)
 	}
 
 	// Final flush
 	if pending {
-		if lastRune == Alifu {
+		if lastRune == Alifu && t.phonetic {
+			b.WriteString("ʔ")
+		} else if lastRune == Alifu {
 			b.WriteByte('h')
 		} else if lastLatin != "" {
 			b.WriteString(lastLatin)
 		}
 	}
+	if al != nil {
+		al.mark(n, b.Len())
+	}
 
 	return b.String()
 }
 
-func isDiphthong(prev, curr rune) bool {
-	pi := int(prev - thaanaBase)
-	ci := int(curr - thaanaBase)
-	if pi < 0 || pi >= thaanaSize || ci < 0 || ci >= thaanaSize {
-		return false
-	}
-	p := vLat[pi]
-	c := vLat[ci]
-	if len(p) == 0 || len(c) == 0 {
-		return false
-	}
-	return (p[len(p)-1] == 'a' || p[len(p)-1] == 'o' || p[len(p)-1] == 'e') &&
-		(c[0] == 'i' || c[0] == 'u')
+// isThaana reports whether r is in the Thaana block (U+0780–U+07BF).
+func isThaana(r rune) bool {
+	return r >= thaanaBase && r <= 0x07BF
+}
+
+// firstLetter returns the first rune of s, so that non-ASCII values (from a
+// scheme or the ALA-LC profile) are never cut mid-character.
+func firstLetter(s string) string {
+	_, size := utf8.DecodeRuneInString(s)
+	return s[:size]
 }
@@ -0,0 +1,143 @@
+package transliterator
+
+// IPA mode runs the same akuru/fili/sukun state machine as the Latin
+// engine over broad IPA tables. The phonetic flag switches the sukun and
+// Noonu rules to their spoken realisation: gemination becomes a length mark
+// (ބައްބަ → babːa), word-final Alifu/Shaviyani + sukun a glottal stop
+// (ރަށް → raʔ), final Noonu + sukun velar (ފެން → feŋ), and Noonu before a
+// stop prenasalization (ކަނޑި → kaⁿɖi).
+
+// ipa holds the tables used by TransliterateIPA.
+var ipa = newIPATables()
+
+var ipaConsonantData = map[rune]string{
+	'ހ': "h",
+	'ށ': "ʃ",
+	'ނ': "n",
+	'ރ': "r",
+	'ބ': "b",
+	'ޅ': "ɭ",
+	'ކ': "k",
+	'އ': "",
+	'ވ': "ʋ",
+	'މ': "m",
+	'ފ': "f",
+	'ދ': "d",
+	'ތ': "t",
+	'ލ': "l",
+	'ގ': "ɡ",
+	'ޏ': "ɲ",
+	'ސ': "s",
+	'ޑ': "ɖ",
+	'ޒ': "z",
+	'ޓ': "ʈ",
+	'ޔ': "j",
+	'ޕ': "p",
+	'ޖ': "d͡ʒ",
+	'ޗ': "t͡ʃ",
+
+	'ޘ': "θ",
+	'ޙ': "ħ",
+	'ޚ': "x",
+	'ޛ': "ð",
+	'ޝ': "ʃ",
+	'ޞ': "sˤ",
+	'ޟ': "dˤ",
+	'ޠ': "tˤ",
+	'ޡ': "ðˤ",
+	'ޢ': "ʔ",
+	'ޣ': "ɣ",
+	'ޤ': "q",
+	'ޥ': "w",
+}
+
+// Arabic-derived letters as most Dhivehi speakers pronounce them.
+var ipaConsonantNormData = map[rune]string{
+	'ޘ': "s",
+	'ޙ': "h",
+	'ޚ': "x",
+	'ޛ': "z",
+	'ޝ': "ʃ",
+	'ޞ': "s",
+	'ޟ': "d",
+	'ޠ': "t",
+	'ޡ': "z",
+	'ޢ': "ʔ",
+	'ޣ': "ɡ",
+	'ޤ': "k",
+	'ޥ': "ʋ",
+}
+
+var ipaVowelData = map[rune]string{
+	'ަ': "a",
+	'ާ': "aː",
+	'ި': "i",
+	'ީ': "iː",
+	'ު': "u",
+	'ޫ': "uː",
+	'ެ': "e",
+	'ޭ': "eː",
+	'ޮ': "o",
+	'ޯ': "oː",
+}
+
+var ipaSukunOverrideData = map[rune]string{
+	'ތ': "ʔ",
+	'ޏ': "",
+	'ޢ': "ʔ",
+}
+
+// newIPATables builds phonetic tables. Every entry of the Latin defaults
+// that the engine reads is overridden, so no Latin leaks into the output.
+func newIPATables() *tables {
+	t := newTables(Config{
+		Consonants:           ipaConsonantData,
+		NormalizedConsonants: ipaConsonantNormData,
+		Vowels:               ipaVowelData,
+		SukunOverrides:       ipaSukunOverrideData,
+	})
+	t.phonetic = true
+	return t
+}
+
+// nasal returns the realisation of Noonu + sukun before next: labial before
+// labials, velar before velars and at the end of a word, dental otherwise.
+func nasal(next rune, consonant bool) string {
+	switch {
+	case next == Meemu || next == Baa || next == Paviyani:
+		return "m"
+	case next == Gaafu || next == Kaafu || !consonant:
+		return "ŋ"
+	}
+	return "n"
+}
+
+// prenasal returns the realisation of a bare Noonu before next, which
+// prenasalizes the voiced stops.
+func prenasal(next rune) string {
+	switch next {
+	case Baa:
+		return "ᵐ"
+	case Dhaalu, Daviyani:
+		return "ⁿ"
+	case Gaafu:
+		return "ᵑ"
+	case Paviyani:
+		return "m"
+	}
+	return "n"
+}
+
+// TransliterateIPA converts Dhivehi (Thaana) text to a broad IPA
+// transcription with default options.
+func TransliterateIPA(input string) string {
+	return ipa.transliterate(input, Options{}, nil)
+}
+
+// TransliterateIPAWithOptions converts Dhivehi (Thaana) text to broad IPA.
+// NormalizeArabic gives the nativized pronunciation of Arabic-derived
+// letters (ޛ → z rather than ð); Gemination and SuppressGlottalStop have no
+// effect, since geminates are always written with a length mark.
+func TransliterateIPAWithOptions(input string, opts Options) string {
+	return ipa.transliterate(input, opts, nil)
+}
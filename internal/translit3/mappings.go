@@ -3,7 +3,7 @@ package transliterator
 // Thaana Unicode range for array-indexed lookups.
 const (
 	thaanaBase rune = 0x0780
-	thaanaSize      = 0x07B1 - 0x0780 // 49 slots: U+0780 through U+07B0
+	thaanaSize      = 0x07B2 - 0x0780 // 50 slots: U+0780 through U+07B1, the dialect letter NAA
 )
 
 // Rune constants for rule-based handling.
@@ -17,10 +17,21 @@ const (
 	Meemu     rune = '\u0789'
 	Baa       rune = '\u0784'
 	Paviyani  rune = '\u0795'
+	Dhaalu    rune = '\u078B'
+	Daviyani  rune = '\u0791'
+	Gaafu     rune = '\u078E'
+	Kaafu     rune = '\u0786'
 )
 
-// Fast lookup arrays indexed by (r - thaanaBase).
-var (
+// Arabic block range for punctuation (Nishaan) lookups.
+const (
+	nishaanBase rune = 0x0600
+	nishaanSize      = 0x0700 - 0x0600
+)
+
+// tables holds the fast lookup arrays, indexed by (r - thaanaBase). Each
+// Transliterator owns its tables; they are never written after newTables.
+type tables struct {
 	cLat     [thaanaSize]string // consonant → Latin (V2-style, preserving Arabic distinctions)
 	cLatNorm [thaanaSize]string // consonant → Latin (V1-style, normalized Arabic)
 	cOk      [thaanaSize]bool   // is consonant?
@@ -34,9 +45,17 @@ var (
 	akNames   [thaanaSize]string // standalone letter names
 	akNamesOk [thaanaSize]bool   // has letter name?
 
-	nishaanLat [256]rune // punctuation lookup (small range, indexed directly)
-	nishaanOk  [256]bool
-)
+	nishaanLat [nishaanSize]rune // punctuation lookup, indexed by (r - nishaanBase)
+	nishaanOk  [nishaanSize]bool
+
+	names map[string]string // whole-word person names, for Options.PersonNames
+
+	phonetic bool // IPA mode: sukun and Noonu rules are realised phonetically
+	literal  bool // ALA-LC mode: Noonu and Ainu are written letter for letter
+}
+
+// std holds the tables built from the default mappings below.
+var std = newTables(Config{})
 
 // V2-style consonant mappings (preserving Arabic distinctions with apostrophe).
 var consonantData = map[rune]string{
@@ -126,13 +145,13 @@ var akuruNameData = map[rune]string{
 	'\u0786': "kaafu",
 	'\u0787': "alifu",
 	'\u0788': "vaavu",
-	'\u0789': "meymu",
+	'\u0789': "meemu",
 	'\u078A': "faafu",
 	'\u078B': "dhaalu",
 	'\u078C': "thaalu",
 	'\u078D': "laamu",
 	'\u078E': "gaafu",
-	'\u078F': "nyaviyani",
+	'\u078F': "gnaviyani",
 	'\u0790': "seenu",
 	'\u0791': "daviyani",
 	'\u0792': "zaviyani",
@@ -143,7 +162,7 @@ var akuruNameData = map[rune]string{
 	'\u0797': "chaviyani",
 
 	'\u0798': "tsaa",
-	'\u0799': "haa",
+	'\u0799': "hhaa",
 	'\u079A': "khaa",
 	'\u079B': "zhaalu",
 	'\u079C': "zaa",
@@ -154,8 +173,8 @@ var akuruNameData = map[rune]string{
 	'\u07A1': "zoa",
 	'\u07A2': "ainu",
 	'\u07A3': "ghainu",
-	'\u07A4': "gaafu",
-	'\u07A5': "vaavu",
+	'\u07A4': "qaafu",
+	'\u07A5': "waavu",
 }
 
 var nishaanData = map[rune]rune{
@@ -164,41 +183,64 @@ var nishaanData = map[rune]rune{
 	'\u061B': ';',
 }
 
-func init() {
-	for r, s := range consonantData {
-		if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-			cLat[i] = s
-			cOk[i] = true
-			cLatNorm[i] = s // default: same as V2
+// newTables builds lookup arrays from the default mappings, with the entries
+// of cfg taking precedence. Runes outside the Thaana (or, for Nishaan,
+// Arabic) block are ignored.
+func newTables(cfg Config) *tables {
+	t := new(tables)
+	for _, m := range []map[rune]string{consonantData, cfg.Consonants} {
+		for r, s := range m {
+			if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
+				t.cLat[i] = s
+				t.cOk[i] = true
+				t.cLatNorm[i] = s // default: same as V2
+			}
+		}
+	}
+	for _, m := range []map[rune]string{consonantNormData, cfg.NormalizedConsonants} {
+		for r, s := range m {
+			if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
+				t.cLatNorm[i] = s // override Arabic-derived letters with normalized form
+			}
 		}
 	}
-	for r, s := range consonantNormData {
-		if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-			cLatNorm[i] = s // override Arabic-derived letters with normalized form
+	for _, m := range []map[rune]string{vowelData, cfg.Vowels} {
+		for r, s := range m {
+			if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
+				t.vLat[i] = s
+				t.vOk[i] = true
+			}
 		}
 	}
-	for r, s := range vowelData {
-		if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-			vLat[i] = s
-			vOk[i] = true
+	for _, m := range []map[rune]string{sukunOverrideData, cfg.SukunOverrides} {
+		for r, s := range m {
+			if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
+				t.skOver[i] = s
+				t.skOverOk[i] = true
+			}
 		}
 	}
-	for r, s := range sukunOverrideData {
-		if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-			skOver[i] = s
-			skOverOk[i] = true
+	for _, m := range []map[rune]string{akuruNameData, cfg.AkuruNames} {
+		for r, s := range m {
+			if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
+				t.akNames[i] = s
+				t.akNamesOk[i] = true
+			}
 		}
 	}
-	for r, s := range akuruNameData {
-		if i := int(r - thaanaBase); i >= 0 && i < thaanaSize {
-			akNames[i] = s
-			akNamesOk[i] = true
+	for _, m := range []map[rune]rune{nishaanData, cfg.Nishaan} {
+		for r, lat := range m {
+			if i := int(r - nishaanBase); i >= 0 && i < nishaanSize {
+				t.nishaanLat[i] = lat
+				t.nishaanOk[i] = true
+			}
 		}
 	}
-	for r, lat := range nishaanData {
-		if int(r) < len(nishaanLat) {
-			nishaanLat[r] = lat
-			nishaanOk[r] = true
+	t.names = make(map[string]string, len(nameData)+len(cfg.Names))
+	for _, m := range []map[string]string{nameData, cfg.Names} {
+		for word, lat := range m {
+			t.names[word] = lat
 		}
 	}
+	return t
 }
@@ -0,0 +1,52 @@
+package transliterator
+
+// nameData holds the conventional Latin spellings of common given and family
+// names, as registered on ID cards and passports, for Options.PersonNames.
+// The rules would give "muhammadhu" for މުޙައްމަދު; registries write
+// "Mohamed". Keys are whole Thaana words.
+var nameData = map[string]string{
+	// Given names
+	"މުޙައްމަދު": "Mohamed",
+	"މުހައްމަދު": "Mohamed",
+	"އަޙްމަދު":   "Ahmed",
+	"އަހުމަދު":   "Ahmed",
+	"ޢަލީ":       "Ali",
+	"އަލީ":       "Ali",
+	"ޙަސަން":     "Hassan",
+	"ހަސަން":     "Hassan",
+	"ޙުސައިން":   "Hussain",
+	"ހުސައިން":   "Hussain",
+	"އިބްރާހީމް": "Ibrahim",
+	"އިސްމާޢީލް": "Ismail",
+	"އާދަމް":     "Adam",
+	"ޢަބްދުއްލާ": "Abdulla",
+	"ޔޫސުފް":     "Yoosuf",
+	"މޫސާ":       "Moosa",
+	"ޢީސާ":       "Eesa",
+	"ޢުމަރު":     "Umar",
+	"ސަޢީދު":     "Saeed",
+	"ރަޝީދު":     "Rasheed",
+	"ނަޝީދު":     "Nasheed",
+	"ވަޙީދު":     "Waheed",
+	"ޝަރީފް":     "Shareef",
+	"ޒާހިރު":     "Zahir",
+	"ޖަމީލް":     "Jameel",
+	"ފަރީދު":     "Fareed",
+	"ޢާއިޝަތު":   "Aishath",
+	"އައިޝަތު":   "Aishath",
+	"ފާޠިމަތު":   "Fathimath",
+	"ފާތިމަތު":   "Fathimath",
+	"ޢާމިނަތު":   "Aminath",
+	"އާމިނަތު":   "Aminath",
+	"މަރިޔަމް":   "Mariyam",
+	"ޚަދީޖާ":     "Khadeeja",
+	"ހަވާ":       "Hawwa",
+	"ޒައިނަބު":   "Zainab",
+	"ނަސީމާ":     "Naseema",
+	"ސަލްމާ":     "Salma",
+
+	// Family names and titles used as names
+	"ދީދީ":     "Didi",
+	"މަނިކު":   "Maniku",
+	"ކަލޭފާނު": "Kalaefaanu",
+}
@@ -0,0 +1,75 @@
+package transliterator
+
+import "strings"
+
+// filiNameData names the fili and sukun for SpellOut. Letter names come
+// from akuruNameData, the canonical table the engines' bare-akuru names
+// agree with.
+var filiNameData = map[rune]string{
+	'ަ': "abafili",
+	'ާ': "aabaafili",
+	'ި': "ibifili",
+	'ީ': "eebeefili",
+	'ު': "ubufili",
+	'ޫ': "ooboofili",
+	'ެ': "ebefili",
+	'ޭ': "eybeyfili",
+	'ޮ': "obofili",
+	'ޯ': "oaboafili",
+	'ް': "sukun",
+}
+
+// SpellOut reads Thaana text letter by letter: every akuru, fili and sukun
+// becomes its name, separated by spaces (ބަސް → "baa abafili seenu sukun").
+// Words are separated by ", " and line breaks are kept. Other characters are
+// kept as they are, with Arabic punctuation mapped as in Transliterate.
+func SpellOut(input string) string {
+	return std.spellOut(input)
+}
+
+// SpellOut is like the package-level SpellOut but uses the Transliterator's
+// letter names.
+func (tr *Transliterator) SpellOut(input string) string {
+	return tr.t.spellOut(input)
+}
+
+func (t *tables) spellOut(input string) string {
+	var b strings.Builder
+	b.Grow(len(input) * 6)
+
+	// The separator is written as soon as a word ends, not when the next
+	// one starts, so streamed chunks cut after whitespace join correctly.
+	inWord := false
+	for _, r := range input {
+		switch r {
+		case '\n':
+			b.WriteByte('\n')
+			inWord = false
+			continue
+		case ' ', '\t':
+			if inWord {
+				b.WriteString(", ")
+			}
+			inWord = false
+			continue
+		}
+
+		if inWord {
+			b.WriteByte(' ')
+		}
+		inWord = true
+		i := int(r - thaanaBase)
+		switch {
+		case i >= 0 && i < thaanaSize && t.akNamesOk[i]:
+			b.WriteString(t.akNames[i])
+		case filiNameData[r] != "":
+			b.WriteString(filiNameData[r])
+		default:
+			if lat, ok := t.nishaan(r); ok {
+				r = lat
+			}
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
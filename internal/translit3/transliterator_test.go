@@ -1,6 +1,12 @@
 package transliterator
 
-import "testing"
+import (
+	"bufio"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
 
 func TestTransliteration(t *testing.T) {
 	tests := []struct {
@@ -189,6 +195,304 @@ func TestCombinedOptions(t *testing.T) {
 	}
 }
 
+func TestTransliterateAligned(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		segs     []Segment
+	}{
+		// Alifu + sukun gemination: one segment for އްބަ
+		{"ބައްބަ", "babba", []Segment{{0, 4, 0, 2}, {4, 12, 2, 5}}},
+		// Ainu + fili reordering
+		{"ޢަމަލް", "a'mal", []Segment{{0, 4, 0, 2}, {4, 8, 2, 4}, {8, 12, 4, 5}}},
+		// Noonu "n'" insertion
+		{"ކަނޑި", "kan'di", []Segment{{0, 4, 0, 2}, {4, 6, 2, 4}, {6, 10, 4, 6}}},
+		// Whitespace and punctuation get their own segments
+		{"ބަސް ބޮ.", "bas bo.", []Segment{{0, 4, 0, 2}, {4, 8, 2, 3}, {8, 9, 3, 4}, {9, 13, 4, 6}, {13, 14, 6, 7}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, segs := TransliterateAligned(tt.input, Options{})
+			if result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+			if !slices.Equal(segs, tt.segs) {
+				t.Errorf("segments = %v, want %v", segs, tt.segs)
+			}
+		})
+	}
+}
+
+func TestTransliterateAlignedCoverage(t *testing.T) {
+	input := "ވިސްނުމެއް ނެތި ކޮށްފި ކަމަކުން އެންމެ ފަހަރަކު ދޭހުގައި ގިސްލަމުން ހިތި ކަރުނަ އޮއްސަން ޖެހި ދެޔޭ ޢުމުރަށް މުޅީން"
+	result, segs := TransliterateAligned(input, Options{Gemination: true})
+	if want := TransliterateWithOptions(input, Options{Gemination: true}); result != want {
+		t.Fatalf("got %q, want %q", result, want)
+	}
+
+	in, out := 0, 0
+	for _, s := range segs {
+		if s.InStart != in || s.OutStart != out {
+			t.Fatalf("segment %v not contiguous with (%d, %d)", s, in, out)
+		}
+		in, out = s.InEnd, s.OutEnd
+	}
+	if in != len(input) || out != len(result) {
+		t.Errorf("segments end at (%d, %d), want (%d, %d)", in, out, len(input), len(result))
+	}
+}
+
+func TestNew(t *testing.T) {
+	houseStyle := New(Config{
+		Consonants: map[rune]string{'ދ': "d", 'ތ': "t"},
+		Vowels:     map[rune]string{'ޯ': "o"},
+		Nishaan:    map[rune]rune{'؟': '!'},
+	})
+	plain := New(Config{})
+
+	tests := []struct {
+		tr       *Transliterator
+		input    string
+		expected string
+	}{
+		{houseStyle, "ދިވެހި", "divehi"},
+		{houseStyle, "ރޯނު؟", "ronu!"},
+		{houseStyle, "ތިން", "tin"},
+		{plain, "ދިވެހި", "dhivehi"},
+		{plain, "ރޯނު؟", "roanu?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			for i := 0; i < 100; i++ {
+				if result := tt.tr.Transliterate(tt.input); result != tt.expected {
+					t.Fatalf("got %q, want %q", result, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNewDoesNotAffectDefaults(t *testing.T) {
+	cfg := Config{Consonants: map[rune]string{'ދ': "d"}}
+	tr := New(cfg)
+	cfg.Consonants['ދ'] = "x"
+
+	if result := tr.Transliterate("ދިވެހި"); result != "divehi" {
+		t.Errorf("instance: got %q, want %q", result, "divehi")
+	}
+	if result := Transliterate("ދިވެހި"); result != "dhivehi" {
+		t.Errorf("package default: got %q, want %q", result, "dhivehi")
+	}
+}
+
+func TestNishaan(t *testing.T) {
+	if result := Transliterate("ދިވެހި، ބަސް؛ ކީއްވެ؟"); result != "dhivehi, bas; keevve?" {
+		t.Errorf("got %q, want %q", result, "dhivehi, bas; keevve?")
+	}
+}
+
+// goldenCases reads a tab-separated golden file from testdata.
+func goldenCases(t *testing.T, name string) (inputs, expected []string) {
+	f, err := os.Open("../../testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if in, out, ok := strings.Cut(line, "\t"); ok {
+			inputs = append(inputs, in)
+			expected = append(expected, out)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return inputs, expected
+}
+
+func TestIPA(t *testing.T) {
+	inputs, expected := goldenCases(t, "golden_ipa.txt")
+	for i, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if result := TransliterateIPA(input); result != expected[i] {
+				t.Errorf("got %q, want %q", result, expected[i])
+			}
+		})
+	}
+}
+
+func TestIPANormalizeArabic(t *testing.T) {
+	opts := Options{NormalizeArabic: true}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ޝަރުޠު", "ʃarutu"},
+		{"ޛިކުރު", "zikuru"},
+		{"ޤައުމު", "kaumu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := TransliterateIPAWithOptions(tt.input, opts)
+			if result != tt.expected {
+				t.Errorf("TransliterateIPAWithOptions(%q, NormalizeArabic) = %q, want %q",
+					tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestALALC(t *testing.T) {
+	inputs, expected := goldenCases(t, "golden_alalc.txt")
+	for i, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if result := TransliterateALALC(input); result != expected[i] {
+				t.Errorf("got %q, want %q", result, expected[i])
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// In Thaana alphabetical order.
+	sorted := []string{
+		"ހަ",
+		"ހިރަ",
+		"ށަ",
+		"ނަ",
+		"ރަ",
+		"ބަސް",
+		"ބަސް ބުނު",
+		"ބަސަ",
+		"ބަސާ",
+		"ބިރު",
+		"ކިރު",
+		"އަ",
+		"ޗާ",
+		"ޙައްޤު",
+		"ޢަމަލް",
+		"ޥާ",
+		"abc",
+	}
+
+	shuffled := slices.Clone(sorted)
+	slices.Reverse(shuffled)
+	slices.SortFunc(shuffled, Compare)
+	if !slices.Equal(shuffled, sorted) {
+		t.Errorf("got %q, want %q", shuffled, sorted)
+	}
+}
+
+func TestCompareSukun(t *testing.T) {
+	// Sukun sorts before every fili, unlike in byte order.
+	if Compare("ބަސް", "ބަސަ") >= 0 {
+		t.Error("ބަސް should sort before ބަސަ")
+	}
+	if Compare("ބަސް", "ބަސް") != 0 {
+		t.Error("equal strings should compare equal")
+	}
+}
+
+func TestSpellOut(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ބަސް", "baa abafili seenu sukun"},
+		{"މީ ޏ", "meemu eebeefili, gnaviyani"},
+		{"ޙ ހ ޤ ގ ޥ ވ", "hhaa, haa, qaafu, gaafu, waavu, vaavu"},
+		{"ރޯނު؟", "raa oaboafili noonu ubufili ?"},
+		{"A123", "A 1 2 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := SpellOut(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPersonNames(t *testing.T) {
+	opts := Options{PersonNames: true}
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"މުޙައްމަދު", "Mohamed"},
+		{"ޢާއިޝަތު ޙުސައިން", "Aishath Hussain"},
+		{"އަޙްމަދު ދީދީ، ހުޅުމާލެ", "Ahmed Didi, hulhumaale"},
+		{"(ޢަލީ)", "(Ali)"},
+		{"މުޙައްމަދުގެ", "muh'ammadhuge"},
+		{"ދިވެހި", "dhivehi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := TransliterateWithOptions(tt.input, opts); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+
+	if result := Transliterate("މުޙައްމަދު"); result == "Mohamed" {
+		t.Error("name lexicon used without PersonNames")
+	}
+}
+
+func TestPersonNamesConfig(t *testing.T) {
+	tr := New(Config{Names: map[string]string{"މުޙައްމަދު": "Mohammed"}})
+	if result := tr.TransliterateWithOptions("މުޙައްމަދު ޢަލީ", Options{PersonNames: true}); result != "Mohammed Ali" {
+		t.Errorf("got %q", result)
+	}
+}
+
+// The name lexicon holds orthographic spellings, so IPA and ALA-LC ignore it.
+func TestPersonNamesScholarly(t *testing.T) {
+	opts := Options{PersonNames: true}
+	input := "މުޙައްމަދު ޢަލީ"
+	if result, want := TransliterateIPAWithOptions(input, opts), TransliterateIPA(input); result != want {
+		t.Errorf("IPA: got %q, want %q", result, want)
+	}
+	if result, want := TransliterateALALCWithOptions(input, opts), TransliterateALALC(input); result != want {
+		t.Errorf("ALA-LC: got %q, want %q", result, want)
+	}
+}
+
+func TestDialect(t *testing.T) {
+	inputs, expected := goldenCases(t, "golden_dialect.txt")
+	for i, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if result := TransliterateDialect(input); result != expected[i] {
+				t.Errorf("got %q, want %q", result, expected[i])
+			}
+		})
+	}
+}
+
+// NAA belongs to the dialect profile only; the default tables leave it
+// untransliterated, as translit1, translit2 and translit4 do.
+func TestNaa(t *testing.T) {
+	if result := Transliterate("ކަޱު"); result != "kaޱu" {
+		t.Errorf("got %q, want %q", result, "kaޱu")
+	}
+	if result := TransliterateDialect("ކަޱު"); result != "kanhu" {
+		t.Errorf("dialect: got %q, want %q", result, "kanhu")
+	}
+}
+
 // --- Benchmarks ---
 
 func BenchmarkTransliterate(b *testing.B) {
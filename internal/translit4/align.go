@@ -0,0 +1,34 @@
+package transliterator
+
+// Segment maps an input byte range to the output byte range it produced.
+// Multi-rune rules (akuru + sukun look-ahead, Alifu + sukun gemination with
+// the akuru it doubles, Ainu + fili, Noonu "n'") yield a single segment
+// covering every rune involved, as in translit3.
+type Segment struct {
+	InStart, InEnd   int
+	OutStart, OutEnd int
+}
+
+// aligner records segment boundaries while the engine writes output.
+type aligner struct {
+	split bool // give Alifu + sukun its own segment, as Syllables needs
+	segs  []Segment
+	in    int
+	out   int
+}
+
+// mark closes the current segment at input offset in and output offset out.
+func (a *aligner) mark(in, out int) {
+	if in > a.in || out > a.out {
+		a.segs = append(a.segs, Segment{a.in, in, a.out, out})
+		a.in, a.out = in, out
+	}
+}
+
+// TransliterateAligned is like TransliterateWithOptions but also returns the
+// alignment between input and output byte ranges, in order.
+func TransliterateAligned(input string, opts Options) (string, []Segment) {
+	var al aligner
+	out := transliterate(input, opts, &al)
+	return out, al.segs
+}
@@ -67,6 +67,21 @@ var akuruValues = [thaanaLen]string{
 	'\u07A5' - thaanaBase: "w",
 }
 
+// akuruNormValues is akuruValues with Arabic-derived letters collapsed to
+// standard Latin (Options.NormalizeArabic), matching translit3.
+var akuruNormValues = func() [thaanaLen]string {
+	v := akuruValues
+	v['\u0798'-thaanaBase] = "th"
+	v['\u0799'-thaanaBase] = "h"
+	v['\u079B'-thaanaBase] = "dh"
+	v['\u079D'-thaanaBase] = "sh"
+	v['\u079E'-thaanaBase] = "s"
+	v['\u079F'-thaanaBase] = "d"
+	v['\u07A0'-thaanaBase] = "th"
+	v['\u07A1'-thaanaBase] = "z"
+	return v
+}()
+
 var filiValues = [thaanaLen]string{
 	'\u07A6' - thaanaBase: "a",
 	'\u07A7' - thaanaBase: "aa",
@@ -112,7 +127,7 @@ var akuruNameValues = [thaanaLen]string{
 	'\u0796' - thaanaBase: "javiyani",
 	'\u0797' - thaanaBase: "chaviyani",
 	'\u0798' - thaanaBase: "tsaa",
-	'\u0799' - thaanaBase: "haa",
+	'\u0799' - thaanaBase: "hhaa",
 	'\u079A' - thaanaBase: "khaa",
 	'\u079B' - thaanaBase: "zhaalu",
 	'\u079C' - thaanaBase: "zaa",
@@ -123,6 +138,6 @@ var akuruNameValues = [thaanaLen]string{
 	'\u07A1' - thaanaBase: "zoa",
 	'\u07A2' - thaanaBase: "ainu",
 	'\u07A3' - thaanaBase: "ghainu",
-	'\u07A4' - thaanaBase: "gaafu",
-	'\u07A5' - thaanaBase: "vaavu",
+	'\u07A4' - thaanaBase: "qaafu",
+	'\u07A5' - thaanaBase: "waavu",
 }
@@ -0,0 +1,122 @@
+package transliterator
+
+import "sort"
+
+// Syllable is one syllable of a Thaana word: an akuru with its fili, closed
+// by any akuru written with sukun. A bare Noonu before another akuru
+// (ކަނޑި → ކަ + ނޑި) prenasalizes the next syllable. Runs of non-Thaana text
+// form a Syllable of their own with zero Akuru and Fili.
+type Syllable struct {
+	Text     string // the syllable's Thaana, a substring of the word
+	Start    int    // byte offset of Text in the word
+	Akuru    rune   // consonant carrying the fili (Alifu for vowel-initial syllables)
+	Prenasal bool   // Akuru is preceded by a bare Noonu
+	Fili     rune   // vowel sign; 0 for a bare akuru
+	Sukun    string // akuru closing the syllable, each written with sukun in Text
+	Latin    string // the part of the word's transliteration produced by Text
+}
+
+// Syllables splits word into syllables, using the same akuru/fili/sukun
+// classification as the transliterator. Each Latin field is cut from the
+// transliteration of the whole word, so contextual rules (gemination,
+// Noonu "n'") are applied and the Latin fields concatenate to it.
+func Syllables(word string) []Syllable {
+	n := len(word)
+	var syls []Syllable
+	cur := -1 // index in syls of the syllable that may still take a coda
+
+	i := 0
+	for i < n {
+		idx, ok := thaanaAt(word, i)
+		if !ok || (akuruMask|filiMask)>>idx&1 == 0 && idx != sukunIdx {
+			// Non-Thaana run, extended up to the next Thaana letter.
+			start := i
+			for i < n {
+				if idx, ok := thaanaAt(word, i); ok && (akuruMask|filiMask)>>idx&1 != 0 {
+					break
+				}
+				i++
+			}
+			syls = append(syls, Syllable{Start: start})
+			cur = -1
+			continue
+		}
+
+		if akuruMask>>idx&1 == 0 {
+			// Stray fili or sukun: attach it to the current syllable.
+			if cur < 0 {
+				syls = append(syls, Syllable{Start: i})
+				cur = len(syls) - 1
+			}
+			if filiMask>>idx&1 != 0 && syls[cur].Fili == 0 {
+				syls[cur].Fili = rune(thaanaBase + idx)
+			}
+			i += 2
+			continue
+		}
+
+		r := rune(thaanaBase + idx)
+		next, hasNext := thaanaAt(word, i+2)
+
+		if hasNext && next == sukunIdx {
+			if cur < 0 {
+				syls = append(syls, Syllable{Start: i})
+				cur = len(syls) - 1
+			}
+			syls[cur].Sukun += string(r)
+			i += 4
+			continue
+		}
+
+		s := Syllable{Start: i, Akuru: r}
+		if idx == noonuIdx && cur >= 0 && syls[cur].Fili != 0 && syls[cur].Sukun == "" &&
+			hasNext && akuruMask>>next&1 != 0 {
+			s.Prenasal = true
+			s.Akuru = rune(thaanaBase + next)
+			i += 2
+		}
+		i += 2
+		if fili, ok := thaanaAt(word, i); ok && filiMask>>fili&1 != 0 {
+			s.Fili = rune(thaanaBase + fili)
+			i += 2
+		}
+		syls = append(syls, s)
+		cur = len(syls) - 1
+	}
+
+	al := aligner{split: true}
+	out := transliterate(word, Options{}, &al)
+	segs := al.segs
+	for k := range syls {
+		end := n
+		if k+1 < len(syls) {
+			end = syls[k+1].Start
+		}
+		syls[k].Text = word[syls[k].Start:end]
+		syls[k].Latin = out[outOffset(segs, syls[k].Start, len(out)):outOffset(segs, end, len(out))]
+	}
+	return syls
+}
+
+// thaanaAt returns the Thaana table index of the rune at byte i.
+func thaanaAt(s string, i int) (uint, bool) {
+	if i+1 >= len(s) || s[i] != 0xDE {
+		return 0, false
+	}
+	idx := uint(s[i+1]) - 0x80
+	return idx, idx < thaanaLen
+}
+
+// outOffset maps input offset in to the output offset where its segment
+// starts. An offset inside a segment maps to the segment's end, so the
+// whole segment's output stays with the earlier syllable.
+func outOffset(segs []Segment, in, outLen int) int {
+	k := sort.Search(len(segs), func(k int) bool { return segs[k].InEnd > in })
+	if k == len(segs) {
+		return outLen
+	}
+	if segs[k].InStart == in {
+		return segs[k].OutStart
+	}
+	return segs[k].OutEnd
+}
@@ -2,14 +2,43 @@ package transliterator
 
 import "unsafe"
 
+// Options configures transliteration features, with the same semantics as
+// translit3.Options.
+type Options struct {
+	Gemination          bool // consonant + sukun + same consonant → doubled output
+	SuppressGlottalStop bool // suppress apostrophe between adjacent vowels (no effect: no glottal stop is emitted)
+	NormalizeArabic     bool // collapse Arabic-derived letters to standard Latin (V1 style)
+}
+
 func Transliterate(input string) string {
+	return transliterate(input, Options{}, nil)
+}
+
+// TransliterateWithOptions converts Dhivehi (Thaana) text to Latin with the given options.
+func TransliterateWithOptions(input string, opts Options) string {
+	return transliterate(input, opts, nil)
+}
+
+// transliterate is the engine proper; al, when non-nil, records alignment.
+func transliterate(input string, opts Options, al *aligner) string {
 	n := len(input)
 	buf := make([]byte, n*2)
 	w := 0
 	prevIdx := -1
+	geminate := false // Alifu + sukun written; the next akuru shares its segment
+
+	values := &akuruValues
+	if opts.NormalizeArabic {
+		values = &akuruNormValues
+	}
 
 	i := 0
 	for i < n {
+		if al != nil && (!geminate || al.split) {
+			al.mark(i, w)
+		}
+		geminate = false
+
 		if input[i] != 0xDE || i+1 >= n {
 			goto nonThaana
 		}
@@ -37,7 +66,7 @@ func Transliterate(input string) string {
 						w += 2
 						w += copy(buf[w:], fili[1:])
 					} else {
-						w += copy(buf[w:], akuruValues[idx])
+						w += copy(buf[w:], values[idx])
 						w += copy(buf[w:], filiValues[nextIdx])
 					}
 					prevIdx = int(nextIdx)
@@ -46,12 +75,19 @@ func Transliterate(input string) string {
 				}
 
 				if nextIdx == sukunIdx {
+					// Gemination: akuru + sukun + same akuru → doubled
+					if opts.Gemination && i+5 < n && input[i+4] == 0xDE && uint(input[i+5])-0x80 == idx {
+						buf = grow(buf, w, len(values[idx])+4, n-i-4)
+						w += copy(buf[w:], values[idx])
+					}
+
 					if idx == alifuIdx || idx == shaviyaniIdx {
 						if i+5 < n && input[i+4] == 0xDE {
 							afterIdx := uint(input[i+5]) - 0x80
-							if akuruMask>>afterIdx&1 != 0 && len(akuruValues[afterIdx]) > 0 {
-								buf[w] = akuruValues[afterIdx][0]
+							if akuruMask>>afterIdx&1 != 0 && len(values[afterIdx]) > 0 {
+								buf[w] = values[afterIdx][0]
 								w++
+								geminate = idx == alifuIdx
 								prevIdx = int(sukunIdx)
 								i += 4
 								continue
@@ -68,14 +104,14 @@ func Transliterate(input string) string {
 						if i+5 < n && input[i+4] == 0xDE {
 							afterIdx := uint(input[i+5]) - 0x80
 							if afterIdx == meymuIdx || afterIdx == baaIdx || afterIdx == paviyaniIdx {
-								buf[w] = akuruValues[afterIdx][0]
+								buf[w] = values[afterIdx][0]
 								w++
 								prevIdx = int(sukunIdx)
 								i += 4
 								continue
 							}
 						}
-						w += copy(buf[w:], akuruValues[idx])
+						w += copy(buf[w:], values[idx])
 						prevIdx = int(sukunIdx)
 						i += 4
 						continue
@@ -84,7 +120,7 @@ func Transliterate(input string) string {
 					if sukunOvrdMask>>idx&1 != 0 {
 						w += copy(buf[w:], sukunOvrdValues[idx])
 					} else {
-						w += copy(buf[w:], akuruValues[idx])
+						w += copy(buf[w:], values[idx])
 					}
 					prevIdx = int(sukunIdx)
 					i += 4
@@ -125,6 +161,7 @@ func Transliterate(input string) string {
 			}
 
 			if akuruNameMask>>idx&1 != 0 {
+				buf = grow(buf, w, len(akuruNameValues[idx]), n-i-2)
 				w += copy(buf[w:], akuruNameValues[idx])
 			}
 			prevIdx = int(idx)
@@ -186,5 +223,22 @@ func Transliterate(input string) string {
 		}
 	}
 
+	if al != nil {
+		al.mark(n, w)
+	}
+
 	return unsafe.String(unsafe.SliceData(buf[:w]), w)
 }
+
+// grow ensures buf can take extra bytes at w and still hold the 2× bound for
+// the rest bytes of input left. Every rule except letter names and
+// gemination stays within 2×, so the initial allocation usually suffices.
+func grow(buf []byte, w, extra, rest int) []byte {
+	need := w + extra + 2*rest
+	if need <= len(buf) {
+		return buf
+	}
+	nb := make([]byte, need+need/2)
+	copy(nb, buf[:w])
+	return nb
+}
@@ -1,6 +1,9 @@
 package transliterator
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 func TestTransliteration(t *testing.T) {
 
@@ -99,3 +102,188 @@ func TestTransliteration(t *testing.T) {
 	}
 
 }
+
+func TestTransliterateAligned(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		segs     []Segment
+	}{
+		// Alifu + sukun emits the next akuru's first byte
+		{"ބައްބަ", "babba", []Segment{{0, 4, 0, 2}, {4, 12, 2, 5}}},
+		// Ainu + fili reordering
+		{"ޢަމަލް", "a'mal", []Segment{{0, 4, 0, 2}, {4, 8, 2, 4}, {8, 12, 4, 5}}},
+		// Noonu "n'" insertion
+		{"ކަނޑި", "kan'di", []Segment{{0, 4, 0, 2}, {4, 6, 2, 4}, {6, 10, 4, 6}}},
+		// Whitespace and Nishaan get their own segments
+		{"ބަސް ؟", "bas ?", []Segment{{0, 4, 0, 2}, {4, 8, 2, 3}, {8, 9, 3, 4}, {9, 11, 4, 5}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, segs := TransliterateAligned(tt.input, Options{})
+			if result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+			if !slices.Equal(segs, tt.segs) {
+				t.Errorf("segments = %v, want %v", segs, tt.segs)
+			}
+		})
+	}
+}
+
+func TestLetterNameExpansion(t *testing.T) {
+	// Bare letter names are up to 4.5× longer than their UTF-8 input.
+	result := Transliterate("ޏޏޏޏ ބ")
+	if want := "gnaviyanignaviyanignaviyanignaviyani baa"; result != want {
+		t.Errorf("got %q, want %q", result, want)
+	}
+}
+
+func TestGemination(t *testing.T) {
+	opts := Options{Gemination: true}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ބައްބަ", "babba"},
+		{"ކައްކަ", "kakka"},
+		{"ބަބްބަ", "babbba"},
+		{"ޘްޘްޘްޘް", "th'th'th'th'th'th'th'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := TransliterateWithOptions(tt.input, opts)
+			if result != tt.expected {
+				t.Errorf("TransliterateWithOptions(%q, Gemination) = %q, want %q",
+					tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeArabic(t *testing.T) {
+	opts := Options{NormalizeArabic: true}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ޝަރުޠު", "sharuthu"},
+		{"ޤައުމު", "qaumu"},
+		{"މުޙައްމަދު", "muhammadhu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := TransliterateWithOptions(tt.input, opts)
+			if result != tt.expected {
+				t.Errorf("TransliterateWithOptions(%q, NormalizeArabic) = %q, want %q",
+					tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCombinedOptions(t *testing.T) {
+	opts := Options{
+		Gemination:          true,
+		SuppressGlottalStop: true,
+		NormalizeArabic:     true,
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ބައްބަ", "babba"},
+		{"ބައެއް", "baeh"},
+		{"ޝަރުޠު", "sharuthu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := TransliterateWithOptions(tt.input, opts)
+			if result != tt.expected {
+				t.Errorf("TransliterateWithOptions(%q, Combined) = %q, want %q",
+					tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSyllables(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []Syllable
+	}{
+		{"ބަސް", []Syllable{
+			{Text: "ބަސް", Start: 0, Akuru: 'ބ', Fili: 'ަ', Sukun: "ސ", Latin: "bas"},
+		}},
+		// Alifu + sukun closes the first syllable; its gemination stays with it
+		{"ބައްބަ", []Syllable{
+			{Text: "ބައް", Start: 0, Akuru: 'ބ', Fili: 'ަ', Sukun: "އ", Latin: "bab"},
+			{Text: "ބަ", Start: 8, Akuru: 'ބ', Fili: 'ަ', Latin: "ba"},
+		}},
+		// Bare Noonu prenasalizes the next syllable
+		{"ކަނޑި", []Syllable{
+			{Text: "ކަ", Start: 0, Akuru: 'ކ', Fili: 'ަ', Latin: "ka"},
+			{Text: "ނޑި", Start: 4, Akuru: 'ޑ', Prenasal: true, Fili: 'ި', Latin: "n'di"},
+		}},
+		// Vowel-initial syllables are carried on Alifu
+		{"އުފަން", []Syllable{
+			{Text: "އު", Start: 0, Akuru: 'އ', Fili: 'ު', Latin: "u"},
+			{Text: "ފަން", Start: 4, Akuru: 'ފ', Fili: 'ަ', Sukun: "ނ", Latin: "fan"},
+		}},
+		{"ޢިޝްޤް", []Syllable{
+			{Text: "ޢިޝްޤް", Start: 0, Akuru: 'ޢ', Fili: 'ި', Sukun: "ޝޤ", Latin: "i'sh'q"},
+		}},
+		{"ބަސް، ބ", []Syllable{
+			{Text: "ބަސް", Start: 0, Akuru: 'ބ', Fili: 'ަ', Sukun: "ސ", Latin: "bas"},
+			{Text: "، ", Start: 8, Latin: ", "},
+			{Text: "ބ", Start: 11, Akuru: 'ބ', Latin: "baa"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := Syllables(tt.input); !slices.Equal(result, tt.expected) {
+				t.Errorf("got %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSyllablesLatin(t *testing.T) {
+	input := "އުޚުއްވަތްތެރިކަމުގެ ރޫޙެއްގައެވެ"
+	var latin, text string
+	for _, s := range Syllables(input) {
+		latin += s.Latin
+		text += s.Text
+	}
+	if text != input {
+		t.Errorf("Text fields join to %q, want %q", text, input)
+	}
+	if want := Transliterate(input); latin != want {
+		t.Errorf("Latin fields join to %q, want %q", latin, want)
+	}
+}
+
+// --- Benchmarks ---
+
+func BenchmarkTransliterate(b *testing.B) {
+	input := "ދިވެހި ބަސް މާލެ އަދު ބޮށް އަންބަރަ ބައެއް ގެއް ޝަރުޠު ޤައުމު ޢާއްމު"
+	for i := 0; i < b.N; i++ {
+		Transliterate(input)
+	}
+}
+
+func BenchmarkTransliterateWithOptions(b *testing.B) {
+	input := "ދިވެހި ބަސް މާލެ އަދު ބޮށް އަންބަރަ ބައެއް ގެއް ޝަރުޠު ޤައުމު ޢާއްމު"
+	opts := Options{Gemination: true, NormalizeArabic: true}
+	for i := 0; i < b.N; i++ {
+		TransliterateWithOptions(input, opts)
+	}
+}
@@ -0,0 +1,48 @@
+package translit
+
+import (
+	translit3 "dhivehi-translit/internal/translit3"
+	translit4 "dhivehi-translit/internal/translit4"
+)
+
+// Segment maps the input byte range [InStart, InEnd) to the output byte
+// range [OutStart, OutEnd) it produced.
+type Segment struct {
+	InStart, InEnd   int
+	OutStart, OutEnd int
+}
+
+// Aligner is implemented by engines that can report which input span
+// produced each output span, e.g. for highlighting search hits or syncing
+// cursors between Thaana and Latin views. Segments are contiguous and cover
+// both the whole input and the whole output.
+type Aligner interface {
+	TransliterateAligned(input string, opts Options) (string, []Segment)
+}
+
+func (v3Engine) TransliterateAligned(input string, opts Options) (string, []Segment) {
+	out, segs := translit3.TransliterateAligned(input, translit3.Options{
+		Gemination:          opts.Gemination,
+		SuppressGlottalStop: opts.SuppressGlottalStop,
+		NormalizeArabic:     opts.NormalizeArabic,
+		PersonNames:         opts.PersonNames,
+	})
+	aligned := make([]Segment, len(segs))
+	for i, s := range segs {
+		aligned[i] = Segment(s)
+	}
+	return out, aligned
+}
+
+func (v4Engine) TransliterateAligned(input string, opts Options) (string, []Segment) {
+	out, segs := translit4.TransliterateAligned(input, translit4.Options{
+		Gemination:          opts.Gemination,
+		SuppressGlottalStop: opts.SuppressGlottalStop,
+		NormalizeArabic:     opts.NormalizeArabic,
+	})
+	aligned := make([]Segment, len(segs))
+	for i, s := range segs {
+		aligned[i] = Segment(s)
+	}
+	return out, aligned
+}
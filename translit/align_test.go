@@ -0,0 +1,64 @@
+package translit
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAligner(t *testing.T) {
+	tests := []struct {
+		engine Engine
+		segs   []Segment
+	}{
+		{V3(), []Segment{{0, 4, 0, 2}, {4, 12, 2, 5}}},
+		{V4(), []Segment{{0, 4, 0, 2}, {4, 12, 2, 5}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.engine.Name(), func(t *testing.T) {
+			al, ok := tt.engine.(Aligner)
+			if !ok {
+				t.Fatalf("%s does not implement Aligner", tt.engine.Name())
+			}
+			out, segs := al.TransliterateAligned("ބައްބަ", Options{})
+			if out != "babba" {
+				t.Errorf("got %q, want %q", out, "babba")
+			}
+			if !slices.Equal(segs, tt.segs) {
+				t.Errorf("segments = %v, want %v", segs, tt.segs)
+			}
+		})
+	}
+}
+
+func TestAlignerBoundariesMatch(t *testing.T) {
+	inputs := []string{
+		"ބައްބަ",
+		"ބައްޕަ ކައްކާ",
+		"ޢަމަލް",
+		"ކަނޑި",
+		"ރަށް ބަސް",
+		"މަންމަ",
+		"ދިވެހި ބަސް، ރާއްޖެ",
+	}
+
+	v3, v4 := V3().(Aligner), V4().(Aligner)
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			_, segs3 := v3.TransliterateAligned(input, Options{})
+			_, segs4 := v4.TransliterateAligned(input, Options{})
+			if b3, b4 := inputBoundaries(segs3), inputBoundaries(segs4); !slices.Equal(b3, b4) {
+				t.Errorf("translit3 boundaries %v, translit4 %v", b3, b4)
+			}
+		})
+	}
+}
+
+// inputBoundaries returns the input end offset of each segment.
+func inputBoundaries(segs []Segment) []int {
+	b := make([]int, len(segs))
+	for i, s := range segs {
+		b[i] = s.InEnd
+	}
+	return b
+}
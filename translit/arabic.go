@@ -0,0 +1,112 @@
+package translit
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	arabic "dhivehi-translit/internal/arabic"
+)
+
+// LigatureMode selects how ArabicConfig treats the Arabic ligature symbols
+// U+FDF0–U+FDFF (ﷲ, ﷺ, ﷽…).
+type LigatureMode int
+
+const (
+	LigatureExpand     LigatureMode = iota // romanized phrase: ﷺ → "sallallaahu alaihi wa sallam"
+	LigatureAbbreviate                     // customary abbreviation where there is one: ﷺ → "(SAW)"
+	LigatureKeep                           // the symbol itself
+	LigatureDrop                           // nothing
+)
+
+// ArabicConfig configures WithArabic.
+type ArabicConfig struct {
+	Ligatures    LigatureMode
+	LigatureText map[rune]string // per-symbol replacements, taking precedence over Ligatures
+}
+
+// RomanizeArabic romanizes Arabic-script text: vocalized words letter by
+// letter (بِسْمِ ٱللَّهِ → "bismi allaahi"), Arabic-Indic and Eastern digits
+// as ASCII, and ligature symbols as their romanized phrase. Tatweel is
+// removed. Unvocalized words cannot be read reliably and are kept in Arabic
+// script, except the name of God; other text is kept as it is.
+func RomanizeArabic(s string) string {
+	return arabic.Romanize(s)
+}
+
+// WithArabic returns an engine that handles the Arabic-script spans of
+// Dhivehi text and runs e on the rest. Arabic words are romanized as by
+// RomanizeArabic, ligature symbols are treated as cfg says, Arabic-Indic and
+// Eastern digits become ASCII and tatweel is removed. Arabic punctuation
+// (، ؛ ؟) is left to e, which maps it as Nishaan.
+func WithArabic(e Engine, cfg ArabicConfig) Engine {
+	return arabicEngine{engine: e, cfg: cfg}
+}
+
+type arabicEngine struct {
+	engine Engine
+	cfg    ArabicConfig
+}
+
+func (e arabicEngine) Name() string    { return e.engine.Name() }
+func (e arabicEngine) Version() string { return e.engine.Version() }
+
+func (e arabicEngine) Transliterate(input string) string {
+	return e.TransliterateWithOptions(input, Options{})
+}
+
+func (e arabicEngine) TransliterateWithOptions(input string, opts Options) string {
+	input = arabic.Normalize(input)
+
+	var b strings.Builder
+	prev := 0
+	flush := func(end int) {
+		if end > prev {
+			b.WriteString(e.engine.TransliterateWithOptions(input[prev:end], opts))
+		}
+	}
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		switch {
+		case arabic.IsLetter(r):
+			j := i + size
+			for j < len(input) {
+				r, size := utf8.DecodeRuneInString(input[j:])
+				if !arabic.IsLetter(r) {
+					break
+				}
+				j += size
+			}
+			flush(i)
+			b.WriteString(arabic.RomanizeWord(input[i:j]))
+			prev, i = j, j
+			continue
+		case arabic.IsLigature(r):
+			flush(i)
+			b.WriteString(e.ligature(r))
+			prev = i + size
+		}
+		i += size
+	}
+	if prev == 0 {
+		return e.engine.TransliterateWithOptions(input, opts)
+	}
+	flush(len(input))
+	return b.String()
+}
+
+// ligature returns the replacement of the ligature symbol r.
+func (e arabicEngine) ligature(r rune) string {
+	if s, ok := e.cfg.LigatureText[r]; ok {
+		return s
+	}
+	switch e.cfg.Ligatures {
+	case LigatureKeep:
+		return string(r)
+	case LigatureDrop:
+		return ""
+	}
+	if s, ok := arabic.Ligature(r, e.cfg.Ligatures == LigatureAbbreviate); ok {
+		return s
+	}
+	return string(r)
+}
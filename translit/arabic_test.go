@@ -0,0 +1,46 @@
+package translit
+
+import "testing"
+
+func TestWithArabic(t *testing.T) {
+	e := WithArabic(V3(), ArabicConfig{})
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ނަބިއްޔާ ﷺ ވިދާޅުވި", "nabiyyaa sallallaahu alaihi wa sallam vidhaalhuvi"},
+		{"ޢަބްދުﷲ", "a'bdhuallaah"},
+		{"بِسْمِ ٱللَّهِ ފަށާނީ", "bismi allaahi fashaanee"},
+		{"١٤٤٥ ވަނަ އަހަރު", "1445 vana aharu"},
+		{"كتاب ފޮތް", "كتاب foiy"},
+		{"ޝަހީދުން؟", "sh'aheedhun?"},
+		{"ދިވެހި", "dhivehi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := e.Transliterate(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWithArabicLigatures(t *testing.T) {
+	input := "ނަބިއްޔާ ﷺ"
+	tests := []struct {
+		cfg      ArabicConfig
+		expected string
+	}{
+		{ArabicConfig{Ligatures: LigatureAbbreviate}, "nabiyyaa (SAW)"},
+		{ArabicConfig{Ligatures: LigatureKeep}, "nabiyyaa ﷺ"},
+		{ArabicConfig{Ligatures: LigatureDrop}, "nabiyyaa "},
+		{ArabicConfig{LigatureText: map[rune]string{'ﷺ': "(PBUH)"}}, "nabiyyaa (PBUH)"},
+	}
+
+	for _, tt := range tests {
+		if result := WithArabic(V4(), tt.cfg).Transliterate(input); result != tt.expected {
+			t.Errorf("%+v: got %q, want %q", tt.cfg, result, tt.expected)
+		}
+	}
+}
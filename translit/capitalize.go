@@ -0,0 +1,233 @@
+package translit
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Capitalizer upper-cases the lower-case output of the engines: the first
+// word of every sentence, and the proper nouns of a list.
+//
+// A sentence starts at the beginning of the text and after ".", "?" or the
+// Arabic question mark "؟". Only the first letter of a word is changed, so
+// digraphs read "Dh" and "Sh", not "DH", and a word-initial apostrophe from
+// Ainu is skipped ("'aammu" → "'Aammu"), while one inside a word is not
+// ("a'mal" → "A'mal").
+type Capitalizer struct {
+	names    map[string]string // lower-cased name → capitalized form
+	maxWords int               // words in the longest name
+}
+
+// NewCapitalizer returns a Capitalizer for the proper nouns in names, written
+// as the engine spells them. A name may have several words ("addoo sitee").
+// Names matched in the text are replaced by their entry when it has any
+// upper-case letters ("MDP", "McDonald"), and otherwise get each word
+// capitalized. Matching ignores case and needs whole words.
+func NewCapitalizer(names []string) *Capitalizer {
+	c := &Capitalizer{names: make(map[string]string, len(names))}
+	for _, name := range names {
+		words := strings.Fields(name)
+		if len(words) == 0 {
+			continue
+		}
+		form := strings.Join(words, " ")
+		if form == strings.ToLower(form) {
+			for i, w := range words {
+				words[i] = capitalizeWord(w)
+			}
+			form = strings.Join(words, " ")
+		}
+		c.names[strings.ToLower(form)] = form
+		c.maxWords = max(c.maxWords, len(words))
+	}
+	return c
+}
+
+// Capitalize capitalizes sentence starts in s, with no proper-noun list.
+func Capitalize(s string) string {
+	return (&Capitalizer{}).Capitalize(s)
+}
+
+// Capitalize returns s with its sentence starts and listed names capitalized.
+func (c *Capitalizer) Capitalize(s string) string {
+	out, _, _ := c.capitalize(s, true, true)
+	return out
+}
+
+// word is a run of letters, digits and apostrophes at s[start:end].
+type word struct {
+	start, end int
+}
+
+// capitalize capitalizes s, which starts a sentence if start is set, and
+// reports whether the text after s does. Unless atEOF, the words at the end
+// of s that may begin a name running on into the text after s are returned
+// as rest, to be capitalized with it, and next is the state before them.
+func (c *Capitalizer) capitalize(s string, start, atEOF bool) (out, rest string, next bool) {
+	words := splitWords(s)
+
+	// sentence[i] is set if words[i] starts a sentence.
+	sentence := make([]bool, len(words))
+	prev := 0
+	for i, w := range words {
+		if endsSentence(s[prev:w.start]) {
+			start = true
+		}
+		sentence[i], start = start, false
+		prev = w.end
+	}
+	if endsSentence(s[prev:]) {
+		start = true
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	prev = 0
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		b.WriteString(s[prev:w.start])
+		if !atEOF && c.open(s, words[i:]) {
+			return b.String(), s[w.start:], sentence[i]
+		}
+		if n, form := c.lookup(s, words[i:]); n > 0 {
+			b.WriteString(form)
+			i += n - 1
+			prev = words[i].end
+			continue
+		}
+		if sentence[i] {
+			b.WriteString(capitalizeWord(s[w.start:w.end]))
+		} else {
+			b.WriteString(s[w.start:w.end])
+		}
+		prev = w.end
+	}
+	b.WriteString(s[prev:])
+	return b.String(), "", start
+}
+
+// open reports whether words, the last words of s, may be the start of a
+// listed name that continues after s.
+func (c *Capitalizer) open(s string, words []word) bool {
+	if len(words) >= c.maxWords || !spaced(s, words) {
+		return false
+	}
+	tail := s[words[len(words)-1].end:]
+	return tail == "" || tail == " "
+}
+
+// lookup returns the number of words of the longest listed name that words
+// begins with, and its capitalized form.
+func (c *Capitalizer) lookup(s string, words []word) (int, string) {
+	for n := min(c.maxWords, len(words)); n > 0; n-- {
+		if !spaced(s, words[:n]) {
+			continue
+		}
+		key := strings.ToLower(s[words[0].start:words[n-1].end])
+		if form, ok := c.names[key]; ok {
+			return n, form
+		}
+	}
+	return 0, ""
+}
+
+// spaced reports whether words are separated by single spaces.
+func spaced(s string, words []word) bool {
+	for i := 1; i < len(words); i++ {
+		if s[words[i-1].end:words[i].start] != " " {
+			return false
+		}
+	}
+	return true
+}
+
+// splitWords returns the words of s.
+func splitWords(s string) []word {
+	var words []word
+	in := false
+	for i, r := range s {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || isApostrophe(r)
+		switch {
+		case isWord && !in:
+			words = append(words, word{start: i})
+			in = true
+		case !isWord && in:
+			words[len(words)-1].end = i
+			in = false
+		}
+	}
+	if in {
+		words[len(words)-1].end = len(s)
+	}
+	return words
+}
+
+// endsSentence reports whether the text between two words ends a sentence.
+func endsSentence(gap string) bool {
+	return strings.ContainsAny(gap, ".?؟")
+}
+
+// capitalizeWord upper-cases the first letter of w, after any leading
+// apostrophes. A word starting with a digit is left as it is.
+func capitalizeWord(w string) string {
+	for i, r := range w {
+		if isApostrophe(r) {
+			continue
+		}
+		if !unicode.IsLower(r) {
+			return w
+		}
+		return w[:i] + string(unicode.ToUpper(r)) + w[i+utf8.RuneLen(r):]
+	}
+	return w
+}
+
+// Capitalized returns an engine that runs e and capitalizes its output
+// with c (nil means sentence starts only). Every call starts a new sentence;
+// NewReader and NewWriter carry the sentence state and any words that may
+// begin a name from chunk to chunk, so a stream is capitalized as one text.
+func Capitalized(e Engine, c *Capitalizer) Engine {
+	if c == nil {
+		c = &Capitalizer{}
+	}
+	return capEngine{engine: e, c: c}
+}
+
+// sentenceEngine is implemented by engines whose output depends on the text
+// before the input. transliterateFrom transliterates input, a chunk of a
+// longer text, in state s and returns the state for the next chunk; atEOF
+// means there is none.
+type sentenceEngine interface {
+	transliterateFrom(input string, opts Options, s sentence, atEOF bool) (string, sentence)
+}
+
+// sentence is the state of a Capitalized engine between chunks.
+type sentence struct {
+	start   bool   // the next chunk starts a sentence
+	pending string // output held back as it may begin a name
+}
+
+type capEngine struct {
+	engine Engine
+	c      *Capitalizer
+}
+
+func (e capEngine) Name() string    { return e.engine.Name() }
+func (e capEngine) Version() string { return e.engine.Version() }
+
+func (e capEngine) Transliterate(input string) string {
+	return e.TransliterateWithOptions(input, Options{})
+}
+
+func (e capEngine) TransliterateWithOptions(input string, opts Options) string {
+	out, _ := e.transliterateFrom(input, opts, sentence{start: true}, true)
+	return out
+}
+
+func (e capEngine) transliterateFrom(input string, opts Options, s sentence, atEOF bool) (string, sentence) {
+	out, rest, start := e.c.capitalize(s.pending+e.engine.TransliterateWithOptions(input, opts), s.start, atEOF)
+	return out, sentence{start: start, pending: rest}
+}
+
+func (e capEngine) holdBack(input string) int { return holdBack(e.engine, input) }
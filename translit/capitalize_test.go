@@ -0,0 +1,129 @@
+package translit
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestCapitalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"dhivehi raajje", "Dhivehi raajje"},
+		{"kobaa? maale. shukuriyya", "Kobaa? Maale. Shukuriyya"},
+		{"kobaa؟ maale", "Kobaa؟ Maale"},
+		{"'aanmu kameh", "'Aanmu kameh"},
+		{"a'mal kameh", "A'mal kameh"},
+		{"ʻamal", "ʻAmal"},
+		{"2024 ga. raajje", "2024 ga. Raajje"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := Capitalize(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCapitalizerNames(t *testing.T) {
+	c := NewCapitalizer([]string{"maale", "addu city", "MDP", "dhivehi", "'aanmu"})
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"dhiyaee maale ah", "Dhiyaee Maale ah"},
+		{"addu city ge", "Addu City ge"},
+		{"addu  city", "Addu  city"},
+		{"mdp ge", "MDP ge"},
+		{"bas dhivehi", "Bas Dhivehi"},
+		{"maaleh", "Maaleh"},
+		{"ekam maaleh", "Ekam maaleh"},
+		{"ekam 'aanmu", "Ekam 'Aanmu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := c.Capitalize(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCapitalizedEngine(t *testing.T) {
+	e := Capitalized(V3(), NewCapitalizer([]string{"maale"}))
+	if result := e.Transliterate("މާލެ ދިޔައީ ކީއްވެ؟ ދިވެހި"); result != "Maale dhiyaee keevve? Dhivehi" {
+		t.Errorf("got %q", result)
+	}
+}
+
+// Each call starts a new sentence, whatever came before.
+func TestCapitalizedEngineRepeat(t *testing.T) {
+	e := Capitalized(V3(), nil)
+	for i := 0; i < 2; i++ {
+		if result := e.Transliterate("ދިވެހި ބަސް"); result != "Dhivehi bas" {
+			t.Errorf("call %d: got %q, want %q", i+1, result, "Dhivehi bas")
+		}
+	}
+}
+
+// A single write larger than the Writer's buffer makes Transform retry with
+// a larger dst; the retry must not lose the sentence start.
+func TestCapitalizedWriterLargeWrite(t *testing.T) {
+	input := strings.Repeat("ދިވެހި ބަސް. ", 800)
+	var buf bytes.Buffer
+	w := NewWriter(&buf, Capitalized(V3(), nil), Options{})
+	if _, err := w.Write([]byte(input)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if want := strings.Repeat("Dhivehi bas. ", 800); buf.String() != want {
+		t.Errorf("output starts %q, want %q", buf.String()[:20], want[:20])
+	}
+}
+
+// A stream is capitalized as one text: a chunk starting mid-sentence keeps
+// its first word lower case.
+func TestCapitalizedStream(t *testing.T) {
+	input := "ދިވެހި ބަސް. ދިވެހި ބަސް"
+	e := Capitalized(V3(), nil)
+	got, err := io.ReadAll(NewReader(iotest.OneByteReader(strings.NewReader(input)), e, Options{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Dhivehi bas. Dhivehi bas"; string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// A name of several words is matched across chunks.
+func TestCapitalizedStreamNames(t *testing.T) {
+	e := Capitalized(V3(), NewCapitalizer([]string{"addoo sitee"}))
+	for _, input := range []string{"ބަސް އައްޑޫ ސިޓީ", "ބަސް އައްޑޫ ސިޓީ ބަސް. އައްޑޫ"} {
+		want := e.Transliterate(input)
+		for _, r := range []io.Reader{
+			iotest.OneByteReader(strings.NewReader(input)),
+			strings.NewReader(input),
+		} {
+			got, err := io.ReadAll(NewReader(r, e, Options{}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != want {
+				t.Errorf("%q: got %q, want %q", input, got, want)
+			}
+		}
+	}
+	if got, want := e.Transliterate("ބަސް އައްޑޫ ސިޓީ"), "Bas Addoo Sitee"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
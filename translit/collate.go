@@ -0,0 +1,26 @@
+package translit
+
+import (
+	"slices"
+
+	translit3 "dhivehi-translit/internal/translit3"
+)
+
+// SortKey returns a key for s whose byte order is Thaana alphabetical order:
+// consonants haa, shaviyani, noonu, raa… with the Arabic-derived letters
+// after chaviyani, and within a consonant sukun before abafili…oaboafili.
+// Spaces sort first, then digits; other runes sort after Thaana.
+func SortKey(s string) []byte {
+	return translit3.SortKey(s)
+}
+
+// Compare returns -1, 0 or +1 as a sorts before, equal to or after b in
+// Thaana alphabetical order.
+func Compare(a, b string) int {
+	return translit3.Compare(a, b)
+}
+
+// Sort sorts s in Thaana alphabetical order.
+func Sort(s []string) {
+	slices.SortFunc(s, translit3.Compare)
+}
@@ -0,0 +1,23 @@
+package translit
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	words := []string{"ބަސަ", "ރަށް", "ޢަމަލް", "ބަސް", "ހަ", "އަ"}
+	Sort(words)
+	want := []string{"ހަ", "ރަށް", "ބަސް", "ބަސަ", "އަ", "ޢަމަލް"}
+	if !slices.Equal(words, want) {
+		t.Errorf("got %q, want %q", words, want)
+	}
+}
+
+func TestSortKeyMatchesCompare(t *testing.T) {
+	a, b := "ބަސް", "ބަސަ"
+	if got, want := bytes.Compare(SortKey(a), SortKey(b)), Compare(a, b); got != want {
+		t.Errorf("key order %d, Compare %d", got, want)
+	}
+}
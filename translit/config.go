@@ -0,0 +1,64 @@
+package translit
+
+import translit3 "dhivehi-translit/internal/translit3"
+
+// Config supplies mapping entries for an engine created with New. Entries
+// take precedence over the translit3 defaults; nil maps keep the defaults.
+// Keys outside the Thaana block (Arabic block for Nishaan) are ignored.
+type Config struct {
+	Consonants           map[rune]string   // akuru → Latin
+	NormalizedConsonants map[rune]string   // akuru → Latin when Options.NormalizeArabic is set
+	Vowels               map[rune]string   // fili → Latin
+	SukunOverrides       map[rune]string   // akuru + sukun → Latin
+	AkuruNames           map[rune]string   // akuru → letter name
+	Nishaan              map[rune]rune     // punctuation → Latin punctuation
+	Names                map[string]string // Thaana name → Latin spelling for Options.PersonNames
+}
+
+// New returns a Qawaaidu (translit3) engine with private, read-only lookup
+// tables built from cfg. Engines returned by New share no mutable state, so
+// differently configured engines can serve different tenants concurrently.
+func New(cfg Config) Engine {
+	return configuredEngine{translit3.New(translit3.Config(cfg))}
+}
+
+// SouthernConfig returns the mapping entries of the Southern engine, so that
+// New(SouthernConfig()) is equivalent to Southern() and a southern profile
+// can be adjusted entry by entry.
+func SouthernConfig() Config {
+	return Config(translit3.DialectConfig())
+}
+
+type configuredEngine struct {
+	tr *translit3.Transliterator
+}
+
+func (configuredEngine) Name() string    { return "translit3" }
+func (configuredEngine) Version() string { return "v3" }
+
+func (e configuredEngine) Transliterate(input string) string {
+	return e.tr.Transliterate(input)
+}
+
+func (e configuredEngine) TransliterateWithOptions(input string, opts Options) string {
+	return e.tr.TransliterateWithOptions(input, translit3.Options{
+		Gemination:          opts.Gemination,
+		SuppressGlottalStop: opts.SuppressGlottalStop,
+		NormalizeArabic:     opts.NormalizeArabic,
+		PersonNames:         opts.PersonNames,
+	})
+}
+
+func (e configuredEngine) TransliterateAligned(input string, opts Options) (string, []Segment) {
+	out, segs := e.tr.TransliterateAligned(input, translit3.Options{
+		Gemination:          opts.Gemination,
+		SuppressGlottalStop: opts.SuppressGlottalStop,
+		NormalizeArabic:     opts.NormalizeArabic,
+		PersonNames:         opts.PersonNames,
+	})
+	aligned := make([]Segment, len(segs))
+	for i, s := range segs {
+		aligned[i] = Segment(s)
+	}
+	return out, aligned
+}
@@ -0,0 +1,39 @@
+package translit
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tenants := []struct {
+		engine   Engine
+		expected string
+	}{
+		{New(Config{Consonants: map[rune]string{'ދ': "d"}}), "divehi"},
+		{New(Config{Consonants: map[rune]string{'ދ': "dh", 'ވ': "w"}}), "dhiwehi"},
+		{New(Config{}), "dhivehi"},
+	}
+
+	var wg sync.WaitGroup
+	for _, tt := range tenants {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if result := tt.engine.Transliterate("ދިވެހި"); result != tt.expected {
+					t.Errorf("got %q, want %q", result, tt.expected)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if result := V3().Transliterate("ދިވެހި"); result != "dhivehi" {
+		t.Errorf("V3 after New: got %q, want %q", result, "dhivehi")
+	}
+	if _, ok := tenants[0].engine.(Aligner); !ok {
+		t.Error("New engine does not implement Aligner")
+	}
+}
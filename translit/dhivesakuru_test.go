@@ -0,0 +1,39 @@
+package translit
+
+import "testing"
+
+func TestDhivesAkuru(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"\U0001191D\U00011931\U00011929\U00011935\U0001192D\U00011931", "dhivehi"},
+		{"\U00011922\U00011920\U0001193E\U00011920", "bappa"},
+		{"\U00011927\U0001192A\U0001193E", "rah"},
+		{"\U00011927\U0001192C\U0001193E\U0001190E\U00011935\U00011921\U00011930\U0001191F\U00011933", "rasgefaanu"},
+		{"\U0001190C\U0001193F\U00011918\U00011931", "kan'di"},
+		{"\U00011924\U00011937 ދިވެހި\U00011944", "mai dhivehi."},
+		{"\U00011951\U00011959", "19"},
+		{"\U0001190C\U0001191A\U00011933", "kanhu"},
+	}
+
+	e := DhivesAkuru()
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := e.Transliterate(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDhivesAkuruOptions(t *testing.T) {
+	input := "\U00011922\U00011920\U0001193E\U00011920"
+	opts := Options{Gemination: true}
+	if result, want := DhivesAkuru().TransliterateWithOptions(input, opts), V3().TransliterateWithOptions("ބައްޕަ", opts); result != want {
+		t.Errorf("got %q, want %q", result, want)
+	}
+	if result := DhivesAkuruThaana().Transliterate(input); result != "ބައްޕަ" {
+		t.Errorf("Thaana: got %q, want %q", result, "ބައްޕަ")
+	}
+}
@@ -0,0 +1,112 @@
+package translit
+
+// Distance returns the character-level Levenshtein distance between a and b.
+func Distance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	na, nb := len(ar), len(br)
+	if na == 0 {
+		return nb
+	}
+	if nb == 0 {
+		return na
+	}
+	// One row of the DP table (we only need previous row).
+	prev := make([]int, nb+1)
+	curr := make([]int, nb+1)
+	for j := 0; j <= nb; j++ {
+		prev[j] = j
+	}
+	for i := 1; i <= na; i++ {
+		curr[0] = i
+		for j := 1; j <= nb; j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = min(curr[j-1]+1, prev[j]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[nb]
+}
+
+// WeightedDistance is Distance with costs lowered for the confusions common
+// in romanized Dhivehi, so that "mohamed" is closer to "muhammadhu" than to
+// an unrelated word of the same length:
+//
+//   - apostrophes (sh', n', ʻ) cost 0.1 to insert or delete
+//   - the h of a digraph (dh, th, lh, sh, kh, gh, ch) costs 0.25
+//   - doubling a letter (aa, ee, mm) costs 0.25
+//   - the y of "ey" costs 0.5
+//   - one vowel for another, and v/w, k/q, s/z, costs 0.5
+//
+// Comparison is case-sensitive; callers usually lower-case both sides.
+func WeightedDistance(a, b string) float64 {
+	ar, br := []rune(a), []rune(b)
+	na, nb := len(ar), len(br)
+
+	prev := make([]float64, nb+1)
+	curr := make([]float64, nb+1)
+	for j := 1; j <= nb; j++ {
+		prev[j] = prev[j-1] + indelCost(br, j-1)
+	}
+	for i := 1; i <= na; i++ {
+		del := indelCost(ar, i-1)
+		curr[0] = prev[0] + del
+		for j := 1; j <= nb; j++ {
+			curr[j] = min(
+				curr[j-1]+indelCost(br, j-1),
+				prev[j]+del,
+				prev[j-1]+substCost(ar[i-1], br[j-1]),
+			)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[nb]
+}
+
+// indelCost is the cost of inserting or deleting s[i], given the rune before it.
+func indelCost(s []rune, i int) float64 {
+	r := s[i]
+	if isApostrophe(r) {
+		return 0.1
+	}
+	if i == 0 {
+		return 1
+	}
+	p := s[i-1]
+	switch {
+	case r == p:
+		return 0.25
+	case r == 'h' && (p == 'd' || p == 't' || p == 'l' || p == 's' || p == 'k' || p == 'g' || p == 'c'):
+		return 0.25
+	case r == 'y' && p == 'e':
+		return 0.5
+	}
+	return 1
+}
+
+func substCost(a, b rune) float64 {
+	switch {
+	case a == b:
+		return 0
+	case isVowel(a) && isVowel(b):
+		return 0.5
+	}
+	if a > b {
+		a, b = b, a
+	}
+	switch [2]rune{a, b} {
+	case [2]rune{'k', 'q'}, [2]rune{'s', 'z'}, [2]rune{'v', 'w'}:
+		return 0.5
+	}
+	return 1
+}
+
+func isVowel(r rune) bool {
+	return r == 'a' || r == 'e' || r == 'i' || r == 'o' || r == 'u'
+}
+
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == 'ʻ' || r == 'ʼ' || r == '’' || r == '`'
+}
@@ -0,0 +1,51 @@
+package translit
+
+import "testing"
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"dhivehi", "divehi", 1},
+		{"ދިވެހި", "ދިވެހި", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if result := Distance(tt.a, tt.b); result != tt.expected {
+				t.Errorf("got %d, want %d", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWeightedDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"", "", 0},
+		{"dhivehi", "dhivehi", 0},
+		{"divehi", "dhivehi", 0.25},
+		{"sharutu", "sh'arut'u", 0.2},
+		{"male", "maale", 0.25},
+		{"divehi", "diveyhi", 0.5},
+		{"veli", "wali", 1},
+		{"bas", "fas", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if result := WeightedDistance(tt.a, tt.b); result != tt.expected {
+				t.Errorf("got %v, want %v", result, tt.expected)
+			}
+			if result := WeightedDistance(tt.b, tt.a); result != tt.expected {
+				t.Errorf("reversed: got %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package translit
+
+import (
+	dhivesakuru "dhivehi-translit/internal/dhivesakuru"
+	reverse "dhivehi-translit/internal/reverse"
+	translit1 "dhivehi-translit/internal/translit1"
+	translit2 "dhivehi-translit/internal/translit2"
+	translit3 "dhivehi-translit/internal/translit3"
+	translit4 "dhivehi-translit/internal/translit4"
+)
+
+// V1 returns the original rule-based engine. Arabic-derived letters are
+// always normalized; supports Gemination and SuppressGlottalStop.
+func V1() Engine { return v1Engine{} }
+
+// V2 returns the map-based engine with letter names for bare consonants.
+// Supports all Options.
+func V2() Engine { return v2Engine{} }
+
+// V3 returns the Qawaaidu-aligned engine. Supports all Options except
+// NoAkuruNames; bare consonants are never spelled out. It is the only
+// built-in engine with PersonNames.
+func V3() Engine { return v3Engine{} }
+
+// V4 returns the byte-level engine tuned for throughput. Supports all Options
+// except NoAkuruNames.
+func V4() Engine { return v4Engine{} }
+
+// ALALC returns the ALA-LC cataloguing romanization of translit3 (macrons,
+// underdots, ALA-LC values for Arabic-derived letters). Only Gemination has
+// an effect.
+func ALALC() Engine { return alalcEngine{} }
+
+// IPA returns the broad IPA transcription mode of translit3. Only
+// NormalizeArabic has an effect.
+func IPA() Engine { return ipaEngine{} }
+
+// Southern returns the southern profile of translit3, for Addu and
+// Fuvahmulah material: it writes the dialect letter NAA (ޱ) and, as spelling
+// choices, ޜ as z, long vowels with macrons and sukun letters as their own
+// consonant. Supports the Options of V3.
+func Southern() Engine { return southernEngine{} }
+
+// Reverse returns the Latin → Thaana engine, which reads Malé Latin as
+// produced by the Thaana → Latin engines. It has no options.
+func Reverse() Engine { return reverseEngine{} }
+
+// DhivesAkuru returns the Dhives Akuru (U+11900 block) engine. It rewrites
+// the Dhives Akuru spans of the input in modern Thaana, resolving virama,
+// vowel signs and conjuncts, and romanizes the result with the V3 tables
+// (plus NAA as "nh"), so the Latin follows the Qawaaidu conventions.
+// Supports the Options of V3.
+func DhivesAkuru() Engine { return dhivesAkuruEngine{} }
+
+// DhivesAkuruThaana returns the Dhives Akuru engine that stops at modern
+// Thaana. Aspirated letters take the Thaana letter of the plain stop and NNA
+// becomes NAA (ޱ). It has no options.
+func DhivesAkuruThaana() Engine { return dhivesAkuruThaanaEngine{} }
+
+type v1Engine struct{}
+
+func (v1Engine) Name() string    { return "translit1" }
+func (v1Engine) Version() string { return "v1" }
+
+func (v1Engine) Transliterate(input string) string {
+	return translit1.Transliterate(input)
+}
+
+func (v1Engine) TransliterateWithOptions(input string, opts Options) string {
+	return translit1.TransliterateWithOptions(input, translit1.Options{
+		Gemination:          opts.Gemination,
+		SuppressGlottalStop: opts.SuppressGlottalStop,
+	})
+}
+
+type v2Engine struct{}
+
+func (v2Engine) Name() string    { return "translit2" }
+func (v2Engine) Version() string { return "v2" }
+
+func (v2Engine) Transliterate(input string) string {
+	return translit2.Transliterate(input)
+}
+
+func (v2Engine) TransliterateWithOptions(input string, opts Options) string {
+	return translit2.TransliterateWithOptions(input, translit2.Options{
+		Gemination:          opts.Gemination,
+		SuppressGlottalStop: opts.SuppressGlottalStop,
+		NormalizeArabic:     opts.NormalizeArabic,
+		NoAkuruNames:        opts.NoAkuruNames,
+	})
+}
+
+type v3Engine struct{}
+
+func (v3Engine) Name() string    { return "translit3" }
+func (v3Engine) Version() string { return "v3" }
+
+func (v3Engine) Transliterate(input string) string {
+	return translit3.Transliterate(input)
+}
+
+func (v3Engine) TransliterateWithOptions(input string, opts Options) string {
+	return translit3.TransliterateWithOptions(input, translit3.Options{
+		Gemination:          opts.Gemination,
+		SuppressGlottalStop: opts.SuppressGlottalStop,
+		NormalizeArabic:     opts.NormalizeArabic,
+		PersonNames:         opts.PersonNames,
+	})
+}
+
+type v4Engine struct{}
+
+func (v4Engine) Name() string    { return "translit4" }
+func (v4Engine) Version() string { return "v4" }
+
+func (v4Engine) Transliterate(input string) string {
+	return translit4.Transliterate(input)
+}
+
+func (v4Engine) TransliterateWithOptions(input string, opts Options) string {
+	return translit4.TransliterateWithOptions(input, translit4.Options{
+		Gemination:          opts.Gemination,
+		SuppressGlottalStop: opts.SuppressGlottalStop,
+		NormalizeArabic:     opts.NormalizeArabic,
+	})
+}
+
+type alalcEngine struct{}
+
+func (alalcEngine) Name() string    { return "translit3-alalc" }
+func (alalcEngine) Version() string { return "v3" }
+
+func (alalcEngine) Transliterate(input string) string {
+	return translit3.TransliterateALALC(input)
+}
+
+func (alalcEngine) TransliterateWithOptions(input string, opts Options) string {
+	return translit3.TransliterateALALCWithOptions(input, translit3.Options{
+		Gemination: opts.Gemination,
+	})
+}
+
+type ipaEngine struct{}
+
+func (ipaEngine) Name() string    { return "translit3-ipa" }
+func (ipaEngine) Version() string { return "v3" }
+
+func (ipaEngine) Transliterate(input string) string {
+	return translit3.TransliterateIPA(input)
+}
+
+func (ipaEngine) TransliterateWithOptions(input string, opts Options) string {
+	return translit3.TransliterateIPAWithOptions(input, translit3.Options{
+		NormalizeArabic: opts.NormalizeArabic,
+	})
+}
+
+type southernEngine struct{}
+
+func (southernEngine) Name() string    { return "translit3-southern" }
+func (southernEngine) Version() string { return "v3" }
+
+func (southernEngine) Transliterate(input string) string {
+	return translit3.TransliterateDialect(input)
+}
+
+func (southernEngine) TransliterateWithOptions(input string, opts Options) string {
+	return translit3.TransliterateDialectWithOptions(input, translit3.Options{
+		Gemination:          opts.Gemination,
+		SuppressGlottalStop: opts.SuppressGlottalStop,
+		NormalizeArabic:     opts.NormalizeArabic,
+		PersonNames:         opts.PersonNames,
+	})
+}
+
+// dhivesAkuruLatin romanizes the Thaana of the Dhives Akuru engine: the V3
+// tables plus NAA, which ToThaana writes for NNA, as in the southern profile.
+var dhivesAkuruLatin = New(Config{Consonants: map[rune]string{'ޱ': "nh"}})
+
+type dhivesAkuruEngine struct{}
+
+func (dhivesAkuruEngine) Name() string    { return "dhivesakuru" }
+func (dhivesAkuruEngine) Version() string { return "d1" }
+
+func (dhivesAkuruEngine) Transliterate(input string) string {
+	return dhivesAkuruLatin.Transliterate(dhivesakuru.ToThaana(input))
+}
+
+func (dhivesAkuruEngine) TransliterateWithOptions(input string, opts Options) string {
+	return dhivesAkuruLatin.TransliterateWithOptions(dhivesakuru.ToThaana(input), opts)
+}
+
+type dhivesAkuruThaanaEngine struct{}
+
+func (dhivesAkuruThaanaEngine) Name() string    { return "dhivesakuru-thaana" }
+func (dhivesAkuruThaanaEngine) Version() string { return "d1" }
+
+func (dhivesAkuruThaanaEngine) Transliterate(input string) string {
+	return dhivesakuru.ToThaana(input)
+}
+
+func (dhivesAkuruThaanaEngine) TransliterateWithOptions(input string, _ Options) string {
+	return dhivesakuru.ToThaana(input)
+}
+
+type reverseEngine struct{}
+
+func (reverseEngine) Name() string    { return "reverse" }
+func (reverseEngine) Version() string { return "r1" }
+
+func (reverseEngine) Transliterate(input string) string {
+	return reverse.Transliterate(input)
+}
+
+func (reverseEngine) TransliterateWithOptions(input string, _ Options) string {
+	return reverse.Transliterate(input)
+}
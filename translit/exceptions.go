@@ -0,0 +1,199 @@
+package translit
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// caseEndings are the suffixes Exceptions strips to find an entry that ends
+// in a vowel and takes them unchanged, longest first: ކޮކާކޯލާއަކީ is the
+// entry ކޮކާކޯލާ plus -akee. -ge and -gai also attach to a final ު.
+var caseEndings = []string{
+	"އަކީ", // -akee, topic
+	"އަށް", // -ah, dative
+	"ގައި", // -gai, locative
+	"އެއް", // -eh, indefinite
+	"އިން", // -in, ablative
+	"ގެ",   // -ge, genitive
+}
+
+// stemEndings are the case endings of an entry that ends in a consonant with
+// ު or sukun, which give way to the ending's own vowel: ކޮމްޕިއުޓަރު gives
+// ކޮމްޕިއުޓަރަށް and ހޮސްޕިޓަލް gives ހޮސްޕިޓަލުގެ. A final ސް becomes ހ
+// (ޕޮލިސް, ޕޮލިހަށް).
+var stemEndings = []string{
+	"ުގައި", // -ugai, locative
+	"ަކީ",   // -akee, topic
+	"ަށް",   // -ah, dative
+	"ުގެ",   // -uge, genitive
+	"ެއް",   // -eh, indefinite
+	"ުން",   // -un, ablative
+}
+
+// Exceptions is a word-level exception lexicon: Thaana words the rules get
+// wrong (loanwords, brand names, irregular spellings) with their Latin
+// spelling. An Exceptions is safe for concurrent use.
+type Exceptions struct {
+	mu    sync.RWMutex
+	words map[string]string
+}
+
+// NewExceptions returns an empty exception lexicon.
+func NewExceptions() *Exceptions {
+	return &Exceptions{words: make(map[string]string)}
+}
+
+// LoadExceptions reads an exception lexicon from a TSV file. See
+// ParseExceptions.
+func LoadExceptions(path string) (*Exceptions, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	x, err := ParseExceptions(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return x, nil
+}
+
+// ParseExceptions reads an exception lexicon in the format of
+// testdata/golden_cases.txt: one "Thaana<TAB>Latin" pair per line, with
+// blank lines and lines starting with "#" ignored. The Thaana side must be a
+// single word; a later line for the same word replaces an earlier one.
+func ParseExceptions(r io.Reader) (*Exceptions, error) {
+	x := NewExceptions()
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		thaana, latin, ok := strings.Cut(line, "\t")
+		thaana, latin = strings.TrimSpace(thaana), strings.TrimSpace(latin)
+		if !ok || latin == "" {
+			return nil, fmt.Errorf("translit: exceptions: line %d: want Thaana<TAB>Latin", n)
+		}
+		if strings.IndexFunc(thaana, func(r rune) bool { return !isThaana(r) }) >= 0 {
+			return nil, fmt.Errorf("translit: exceptions: line %d: %q is not a Thaana word", n, thaana)
+		}
+		x.Add(thaana, latin)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("translit: exceptions: %w", err)
+	}
+	return x, nil
+}
+
+// Add sets the Latin spelling of the Thaana word thaana.
+func (x *Exceptions) Add(thaana, latin string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.words[thaana] = latin
+}
+
+// Len returns the number of entries.
+func (x *Exceptions) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.words)
+}
+
+// lookup returns the spelling of word, either an entry or an inflected form
+// of one, whose ending is spelled by suffix.
+func (x *Exceptions) lookup(word string, suffix func(string) string) (string, bool) {
+	latin, end, ok := x.find(word)
+	if ok && end != "" {
+		latin += suffix(end)
+	}
+	return latin, ok
+}
+
+// find returns the entry for word and the Thaana ending that follows it. An
+// ending that starts with a fili is returned on Alifu, so that suffix can
+// transliterate it as a word of its own.
+func (x *Exceptions) find(word string) (latin, end string, ok bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	if latin, ok := x.words[word]; ok {
+		return latin, "", true
+	}
+	for _, e := range caseEndings {
+		if stem, cut := strings.CutSuffix(word, e); cut && stem != "" {
+			if latin, ok := x.words[stem]; ok {
+				return latin, e, true
+			}
+		}
+	}
+	for _, e := range stemEndings {
+		base, cut := strings.CutSuffix(word, e)
+		if !cut {
+			continue
+		}
+		for _, stem := range stems(base) {
+			latin, ok := x.words[stem]
+			if !ok {
+				continue
+			}
+			// The ު of -uge and -un is the entry's own.
+			if rest, cut := strings.CutPrefix(e, "ު"); cut && strings.HasSuffix(stem, "ު") {
+				return latin, rest, true
+			}
+			return latin, "އ" + e, true
+		}
+	}
+	return "", "", false
+}
+
+// stems returns the dictionary forms a word may have when base is what is
+// left of it without a stem ending: base + ު and base + sukun, and for a base
+// ending in ހ also the form with ސް.
+func stems(base string) []string {
+	r, _ := utf8.DecodeLastRuneInString(base)
+	if r < akuruFirst || r > akuruLast {
+		return nil
+	}
+	forms := []string{base + "ު", base + "ް"}
+	if stem, cut := strings.CutSuffix(base, "ހ"); cut && stem != "" {
+		forms = append(forms, stem+"ސް")
+	}
+	return forms
+}
+
+// WithExceptions returns an engine that writes the words in x with their
+// lexicon spelling and runs e on everything else. Entries match whole words,
+// bare or inflected for case (-ge, -ah, -akee, -gai, -eh, -in/-un), with the
+// stem changes of caseEndings and stemEndings; e transliterates the ending.
+func WithExceptions(e Engine, x *Exceptions) Engine {
+	return exceptionEngine{engine: e, x: x}
+}
+
+type exceptionEngine struct {
+	engine Engine
+	x      *Exceptions
+}
+
+func (e exceptionEngine) Name() string    { return e.engine.Name() }
+func (e exceptionEngine) Version() string { return e.engine.Version() }
+
+func (e exceptionEngine) Transliterate(input string) string {
+	return e.TransliterateWithOptions(input, Options{})
+}
+
+func (e exceptionEngine) TransliterateWithOptions(input string, opts Options) string {
+	translate := func(s string) string {
+		return e.engine.TransliterateWithOptions(s, opts)
+	}
+	lookup := func(word string) (string, bool) {
+		return e.x.lookup(word, translate)
+	}
+	return replaceWords(input, 1, lookup, translate)
+}
+
+func (e exceptionEngine) holdBack(input string) int { return holdBack(e.engine, input) }
@@ -0,0 +1,63 @@
+package translit
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExceptions(t *testing.T) {
+	x, err := LoadExceptions(filepath.Join("..", "testdata", "exceptions.txt"))
+	if err != nil {
+		t.Fatalf("LoadExceptions: %v", err)
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ކޮމްޕިއުޓަރު", "computer"},
+		{"ކޮމްޕިއުޓަރުގެ", "computerge"},
+		{"ކޮމްޕިއުޓަރަށް", "computerah"},
+		{"ކޮމްޕިއުޓަރެއް", "computereh"},
+		{"ކޮމްޕިއުޓަރުން", "computern"},
+		{"ހޮސްޕިޓަލަށް", "hospitalah"},
+		{"ހޮސްޕިޓަލުގައި", "hospitalugai"},
+		{"ސްކޫލަކީ ރަނގަޅު ތަނެއް", "schoolakee ran'galhu thaneh"},
+		{"ޕޮލިހުގެ", "policeuge"},
+		{"ކޮކާކޯލާއަކީ", "Coca-Colaakee"},
+		{"ފޭސްބުކް، ކޮކާކޯލާ", "Facebook, Coca-Cola"},
+		{"ދިވެހި", "dhivehi"},
+	}
+
+	for _, e := range []Engine{V2(), V3(), V4()} {
+		w := WithExceptions(e, x)
+		for _, tt := range tests {
+			t.Run(e.Name()+"/"+tt.input, func(t *testing.T) {
+				if result := w.Transliterate(tt.input); result != tt.expected {
+					t.Errorf("got %q, want %q", result, tt.expected)
+				}
+			})
+		}
+	}
+}
+
+func TestParseExceptionsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		tsv  string
+	}{
+		{"NoTab", "ކޮމްޕިއުޓަރު computer\n"},
+		{"EmptyLatin", "ކޮމްޕިއުޓަރު\t\n"},
+		{"Phrase", "ދިވެހި ބަސް\tDhivehi\n"},
+		{"LatinKey", "computer\tcomputer\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseExceptions(strings.NewReader(tt.tsv)); err == nil {
+				t.Errorf("ParseExceptions(%q) succeeded, want error", tt.tsv)
+			}
+		})
+	}
+}
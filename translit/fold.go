@@ -0,0 +1,101 @@
+package translit
+
+import (
+	"strings"
+	"unicode"
+)
+
+// foldDigraphs collapses the spellings that users interchange freely.
+// Long vowels are shortened here and by the repeated-letter pass in foldLatin.
+var foldDigraphs = strings.NewReplacer(
+	"dh", "d",
+	"th", "t",
+	"lh", "l",
+	"ee", "i",
+	"oo", "u",
+	"ey", "e",
+	"oa", "o",
+)
+
+// foldLetters maps accented Latin letters (ALA-LC output, French-style
+// "Malé") to their plain spelling.
+var foldLetters = map[rune]string{
+	'á': "a", 'à': "a", 'â': "a", 'ä': "a", 'ā': "a",
+	'é': "e", 'è': "e", 'ê': "e", 'ë': "e", 'ē': "e",
+	'í': "i", 'ì': "i", 'î': "i", 'ï': "i", 'ī': "i",
+	'ó': "o", 'ò': "o", 'ô': "o", 'ö': "o", 'ō': "o",
+	'ú': "u", 'ù': "u", 'û': "u", 'ü': "u", 'ū': "u",
+	'ḍ': "d", 'ḷ': "l", 'ṭ': "t", 'ṣ': "s", 'ḥ': "h", 'ż': "z",
+	'ñ': "gn",
+}
+
+// Fold returns the search key of s. Thaana is romanized with the translit3
+// engine first; Latin, including free-form user input, is then folded so
+// that spelling variants share a key: "dhivehi", "divehi" and "diveyhi" all
+// fold to "divehi", as does ދިވެހި.
+//
+// Folding lower-cases, strips accents and apostrophes, collapses dh/d, th/t,
+// lh/l, ee/i, oo/u, ey/e and oa/o, and squeezes repeated letters. Words are
+// separated by single spaces; other punctuation is dropped.
+func Fold(s string) string {
+	return FoldWith(V3(), s)
+}
+
+// FoldWith is like Fold but romanizes Thaana with e, with NormalizeArabic set.
+func FoldWith(e Engine, s string) string {
+	if hasThaana(s) {
+		s = e.TransliterateWithOptions(s, Options{NormalizeArabic: true})
+	}
+	return foldLatin(s)
+}
+
+func hasThaana(s string) bool {
+	for _, r := range s {
+		if r >= 0x0780 && r <= 0x07BF {
+			return true
+		}
+	}
+	return false
+}
+
+// foldLatin folds romanized text to its search key.
+func foldLatin(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	for _, r := range s {
+		r = unicode.ToLower(r)
+		switch {
+		case r < 0x80 && (r >= 'a' && r <= 'z' || r >= '0' && r <= '9'):
+		case unicode.IsSpace(r):
+			space = b.Len() > 0
+			continue
+		case foldLetters[r] != "":
+			if space {
+				b.WriteByte(' ')
+				space = false
+			}
+			b.WriteString(foldLetters[r])
+			continue
+		default:
+			// apostrophes, ʻ, combining marks and other punctuation
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+
+	// Squeeze after collapsing, so that "dhdh" folds like "d".
+	folded := foldDigraphs.Replace(b.String())
+	out := make([]byte, 0, len(folded))
+	for i := 0; i < len(folded); i++ {
+		if i > 0 && folded[i] == folded[i-1] && folded[i] != ' ' {
+			continue
+		}
+		out = append(out, folded[i])
+	}
+	return string(out)
+}
@@ -0,0 +1,50 @@
+package translit
+
+import "testing"
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"dhivehi", "divehi"},
+		{"divehi", "divehi"},
+		{"diveyhi", "divehi"},
+		{"Dhivehi", "divehi"},
+		{"ދިވެހި", "divehi"},
+		{"reethi", "riti"},
+		{"kooru", "kuru"},
+		{"roanu", "ronu"},
+		{"maale", "male"},
+		{"Malé", "male"},
+		{"māle", "male"},
+		{"kan'di", "kandi"},
+		{"sh'arut'u", "sharutu"},
+		{"ޝަރުޠު", "sharutu"},
+		{"a'mal", "amal"},
+		{"ʻamal", "amal"},
+		{"olhu", "olu"},
+		{"koḷumaḍulu", "kolumadulu"},
+		{"Mohammed", "mohamed"},
+		{"  dhivehi,   bas. ", "divehi bas"},
+		{"ދިވެހި، ބަސް؟", "divehi bas"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := Fold(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFoldWith(t *testing.T) {
+	for _, e := range []Engine{V1(), V2(), V3(), V4(), ALALC()} {
+		t.Run(e.Name(), func(t *testing.T) {
+			if result := FoldWith(e, "ދިވެހި ރާއްޖެ"); result != "divehi raje" {
+				t.Errorf("got %q, want %q", result, "divehi raje")
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package translit
+
+import (
+	"strings"
+	"sync"
+)
+
+// gazetteerData holds the official Latin spellings of atolls, cities and
+// inhabited islands, keyed by their Thaana spelling. Atolls are listed by
+// the administrative codes used in addresses, with their full stop
+// (ގދ. ތިނަދޫ → "GDh. Thinadhoo").
+var gazetteerData = map[string]string{
+	// Administrative atolls, by code. Codes match only with their full stop,
+	// so a letter standing alone keeps its usual transliteration.
+	"ހއ.": "HA.",
+	"ހދ.": "HDh.",
+	"ށ.":  "Sh.",
+	"ނ.":  "N.",
+	"ރ.":  "R.",
+	"ބ.":  "B.",
+	"ޅ.":  "Lh.",
+	"ކ.":  "K.",
+	"އއ.": "AA.",
+	"އދ.": "ADh.",
+	"ވ.":  "V.",
+	"މ.":  "M.",
+	"ފ.":  "F.",
+	"ދ.":  "Dh.",
+	"ތ.":  "Th.",
+	"ލ.":  "L.",
+	"ގއ.": "GA.",
+	"ގދ.": "GDh.",
+	"ޏ.":  "Gn.",
+	"ސ.":  "S.",
+
+	// Cities
+	"މާލެ":              "Malé",
+	"މާލެ ސިޓީ":         "Malé City",
+	"އައްޑޫ":            "Addu",
+	"އައްޑޫ ސިޓީ":       "Addu City",
+	"ފުވައްމުލައް":      "Fuvahmulah",
+	"ފުވައްމުލައް ސިޓީ": "Fuvahmulah City",
+	"ކުޅުދުއްފުށި":      "Kulhudhuffushi",
+	"ކުޅުދުއްފުށި ސިޓީ": "Kulhudhuffushi City",
+	"ތިނަދޫ":            "Thinadhoo",
+	"ތިނަދޫ ސިޓީ":       "Thinadhoo City",
+
+	// Greater Malé
+	"ހުޅުމާލެ": "Hulhumalé",
+	"ވިލިމާލެ": "Vilimalé",
+	"ހުޅުލެ":   "Hulhulé",
+	"ތިލަފުށި": "Thilafushi",
+
+	// Atoll capitals and other inhabited islands
+	"ދިއްދޫ":     "Dhidhdhoo",
+	"ހަނިމާދޫ":   "Hanimaadhoo",
+	"ފުނަދޫ":     "Funadhoo",
+	"މަނަދޫ":     "Manadhoo",
+	"ވޭމަންޑޫ":   "Veymandoo",
+	"އުނގޫފާރު":  "Ungoofaaru",
+	"ދުވާފަރު":   "Dhuvaafaru",
+	"އޭދަފުށި":   "Eydhafushi",
+	"ނައިފަރު":   "Naifaru",
+	"ތުލުސްދޫ":   "Thulusdhoo",
+	"މާފުށި":     "Maafushi",
+	"ކާށިދޫ":     "Kaashidhoo",
+	"ރަސްދޫ":     "Rasdhoo",
+	"މަހިބަދޫ":   "Mahibadhoo",
+	"ފެލިދޫ":     "Felidhoo",
+	"މުލި":       "Muli",
+	"ނިލަންދޫ":   "Nilandhoo",
+	"ކުޑަހުވަދޫ": "Kudahuvadhoo",
+	"ފޮނަދޫ":     "Fonadhoo",
+	"ގަން":       "Gan",
+	"ވިލިނގިލި":  "Villingili",
+	"ހިތަދޫ":     "Hithadhoo",
+	"މަރަދޫ":     "Maradhoo",
+	"ފޭދޫ":       "Feydhoo",
+	"މީދޫ":       "Meedhoo",
+	"ހުޅުދޫ":     "Hulhudhoo",
+}
+
+// Gazetteer maps Thaana place names to their official Latin spellings
+// ("މާލެ" → "Malé" rather than the rule output "maale"). Entries are whole
+// words or phrases of words separated by single spaces, optionally ending in
+// a full stop, which must then follow the word in the text. A Gazetteer is
+// safe for concurrent use.
+type Gazetteer struct {
+	mu       sync.RWMutex
+	names    map[string]string
+	maxWords int
+}
+
+// NewGazetteer returns a gazetteer with the built-in atolls, cities and
+// islands, overridden by overrides. An override with an empty spelling
+// removes the built-in entry.
+func NewGazetteer(overrides map[string]string) *Gazetteer {
+	g := &Gazetteer{names: make(map[string]string, len(gazetteerData)+len(overrides))}
+	for thaana, latin := range gazetteerData {
+		g.Set(thaana, latin)
+	}
+	for thaana, latin := range overrides {
+		g.Set(thaana, latin)
+	}
+	return g
+}
+
+// Set sets the official spelling of the place name thaana, replacing any
+// existing entry. An empty latin removes the entry.
+func (g *Gazetteer) Set(thaana, latin string) {
+	thaana = strings.Join(strings.Fields(thaana), " ")
+	if thaana == "" {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if latin == "" {
+		delete(g.names, thaana)
+		return
+	}
+	g.names[thaana] = latin
+	g.maxWords = max(g.maxWords, strings.Count(thaana, " ")+1)
+}
+
+// Lookup returns the official spelling of the place name thaana.
+func (g *Gazetteer) Lookup(thaana string) (string, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	latin, ok := g.names[thaana]
+	return latin, ok
+}
+
+// Len returns the number of entries.
+func (g *Gazetteer) Len() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return len(g.names)
+}
+
+// WithGazetteer returns an engine that runs e but writes the places in g with
+// their official spelling. Only whole words match: ގދ. ތިނަދޫ becomes
+// "GDh. Thinadhoo", while the inflected ތިނަދޫއަށް and the bare letter ގ are
+// left to the rules. A nil g means the built-in gazetteer.
+func WithGazetteer(e Engine, g *Gazetteer) Engine {
+	if g == nil {
+		g = NewGazetteer(nil)
+	}
+	return gazetteerEngine{engine: e, g: g}
+}
+
+type gazetteerEngine struct {
+	engine Engine
+	g      *Gazetteer
+}
+
+func (e gazetteerEngine) Name() string    { return e.engine.Name() }
+func (e gazetteerEngine) Version() string { return e.engine.Version() }
+
+func (e gazetteerEngine) Transliterate(input string) string {
+	return e.TransliterateWithOptions(input, Options{})
+}
+
+func (e gazetteerEngine) TransliterateWithOptions(input string, opts Options) string {
+	e.g.mu.RLock()
+	maxWords := e.g.maxWords
+	e.g.mu.RUnlock()
+	return replaceWords(input, maxWords, e.g.Lookup, func(s string) string {
+		return e.engine.TransliterateWithOptions(s, opts)
+	})
+}
+
+func (e gazetteerEngine) holdBack(input string) int {
+	e.g.mu.RLock()
+	maxWords := e.g.maxWords
+	e.g.mu.RUnlock()
+	n := heldWords(input, maxWords, e.g.Lookup)
+	return n + holdBack(e.engine, input[:len(input)-n])
+}
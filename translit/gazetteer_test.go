@@ -0,0 +1,46 @@
+package translit
+
+import "testing"
+
+func TestGazetteer(t *testing.T) {
+	e := WithGazetteer(V3(), nil)
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"މާލެ", "Malé"},
+		{"ހުޅުމާލެ", "Hulhumalé"},
+		{"އައްޑޫ ސިޓީ", "Addu City"},
+		{"އައްޑޫ  ސިޓީ", "Addu  sitee"},
+		{"ގދ. ތިނަދޫ", "GDh. Thinadhoo"},
+		{"މާލެ އަށް ދިޔައީ", "Malé ah dhiyaee"},
+		{"ބޮޑު މާލެ، ކުޑަ މާލެ", "bodu Malé, kuda Malé"},
+		{"މާލެއަށް", "maaleah"},
+		{"ދިވެހި", "dhivehi"},
+		{"ބ ދ ނ", "b dh n"},
+		{"ށ. ފުނަދޫ", "Sh. Funadhoo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := e.Transliterate(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGazetteerOverrides(t *testing.T) {
+	g := NewGazetteer(map[string]string{
+		"މާލެ":   "Male'",
+		"ގދ.":    "",
+		"ދިވެހި": "Dhivehi",
+	})
+	e := WithGazetteer(V4(), g)
+	if result := e.Transliterate("މާލެ ދިވެހި ގދ."); result != "Male' Dhivehi gaafudhaalu." {
+		t.Errorf("got %q", result)
+	}
+	if _, ok := g.Lookup("ގދ."); ok {
+		t.Error("removed entry still present")
+	}
+}
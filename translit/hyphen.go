@@ -0,0 +1,70 @@
+package translit
+
+import "strings"
+
+// SoftHyphen is U+00AD, the default marker of Hyphenate.
+const SoftHyphen = "\u00AD"
+
+// Hyphenate transliterates input with translit4 and inserts marker at the
+// syllable boundaries of each Thaana word, so that typesetters can break
+// long romanized words: with marker "-", ކަނޑި becomes "ka-n'di". An empty
+// marker means SoftHyphen.
+//
+// Only boundaries between two voweled syllables are marked, and one is
+// skipped when it would split a digraph (a consonant and a following "h",
+// as in dh, th, lh, sh) or separate an apostrophe from its letter (sh', n'),
+// so the pieces always read as the whole word does.
+func Hyphenate(input, marker string) string {
+	if marker == "" {
+		marker = SoftHyphen
+	}
+	syls := Syllables(input)
+
+	var b strings.Builder
+	b.Grow(len(input) * 2)
+	for i, s := range syls {
+		if i > 0 && breakable(syls[i-1], s) {
+			b.WriteString(marker)
+		}
+		b.WriteString(s.Latin)
+	}
+	return b.String()
+}
+
+// breakable reports whether a marker may go between syllables a and b.
+func breakable(a, b Syllable) bool {
+	// Both sides need a vowel: no break next to punctuation, a bare akuru
+	// or a leading cluster (ސްކޫލް → "skool", not "s-kool").
+	if a.Fili == 0 || b.Fili == 0 || a.Latin == "" || b.Latin == "" {
+		return false
+	}
+	last, first := a.Latin[len(a.Latin)-1], b.Latin[0]
+	switch {
+	case first == '\'':
+		return false
+	case first == 'h' && strings.IndexByte("cdgklstz", last) >= 0:
+		return false
+	}
+	return true
+}
+
+// Hyphenator returns an engine that transliterates like Hyphenate, for use
+// with NewReader, NewWriter and the registry. Options are ignored.
+func Hyphenator(marker string) Engine {
+	return hyphenEngine{marker}
+}
+
+type hyphenEngine struct {
+	marker string
+}
+
+func (hyphenEngine) Name() string    { return "translit4-hyphen" }
+func (hyphenEngine) Version() string { return "v4" }
+
+func (e hyphenEngine) Transliterate(input string) string {
+	return Hyphenate(input, e.marker)
+}
+
+func (e hyphenEngine) TransliterateWithOptions(input string, _ Options) string {
+	return Hyphenate(input, e.marker)
+}
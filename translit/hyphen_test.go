@@ -0,0 +1,56 @@
+package translit
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHyphenate(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"އުޚުއްވަތްތެރިކަމުގެ", "u-khuv-vaiy-the-ri-ka-mu-ge"},
+		{"ކަނޑި", "ka-n'di"},
+		{"ދިވެހި، ބަސް", "dhi-ve-hi, bas"},
+		{"މުޙައްމަދު", "mu-h'am-ma-dhu"},
+		{"ނިޝާން", "ni-sh'aan"},
+		{"ސްކޫލް", "skool"},
+		{"ބ ބަ", "baa ba"},
+		// t + h and d + h are never split, whatever the Thaana
+		{"ބަޓްހަ", "batha"},
+		{"ބަޑްހަ", "badha"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := Hyphenate(tt.input, "-"); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHyphenateSoft(t *testing.T) {
+	result := Hyphenate("ދިވެހި", "")
+	if result != "dhi\u00ADve\u00ADhi" {
+		t.Errorf("got %q, want soft hyphens", result)
+	}
+	if strings.ReplaceAll(result, SoftHyphen, "") != V4().Transliterate("ދިވެހި") {
+		t.Errorf("removing soft hyphens does not give the plain transliteration")
+	}
+}
+
+func TestHyphenator(t *testing.T) {
+	var out strings.Builder
+	w := NewWriter(&out, Hyphenator("|"), Options{})
+	if _, err := w.Write([]byte("ކަނޑި ދިވެހި")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if out.String() != "ka|n'di dhi|ve|hi" {
+		t.Errorf("got %q, want %q", out.String(), "ka|n'di dhi|ve|hi")
+	}
+}
@@ -0,0 +1,80 @@
+package translit
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Match is a lexicon entry scored against a query.
+type Match struct {
+	Headword string  // Thaana headword as added
+	Latin    string  // its transliteration by the lexicon's engine
+	Distance float64 // WeightedDistance between query and Latin
+	Score    float64 // 1 - Distance / longer length, in [0, 1]
+}
+
+// Lexicon is a list of Thaana headwords indexed by their transliteration,
+// queried with approximate Latin. It is safe for concurrent use.
+type Lexicon struct {
+	engine Engine
+
+	mu      sync.RWMutex
+	entries []Match // Distance and Score unset
+}
+
+// NewLexicon returns an empty Lexicon that transliterates headwords with e.
+func NewLexicon(e Engine) *Lexicon {
+	return &Lexicon{engine: e}
+}
+
+// Add transliterates and stores the given headwords. Duplicates are kept.
+func (l *Lexicon) Add(headwords ...string) {
+	entries := make([]Match, len(headwords))
+	for i, h := range headwords {
+		entries[i] = Match{Headword: h, Latin: strings.ToLower(l.engine.Transliterate(h))}
+	}
+	l.mu.Lock()
+	l.entries = append(l.entries, entries...)
+	l.mu.Unlock()
+}
+
+// Len returns the number of headwords in the lexicon.
+func (l *Lexicon) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.entries)
+}
+
+// Query returns up to n entries closest to the Latin query, best first, with
+// ties broken by headword. Entries with a score of zero are left out; with
+// n <= 0 all remaining entries are returned.
+func (l *Lexicon) Query(query string, n int) []Match {
+	q := strings.ToLower(strings.TrimSpace(query))
+	qLen := utf8.RuneCountInString(q)
+
+	l.mu.RLock()
+	matches := make([]Match, 0, len(l.entries))
+	for _, m := range l.entries {
+		m.Distance = WeightedDistance(q, m.Latin)
+		if longer := max(qLen, utf8.RuneCountInString(m.Latin)); longer > 0 {
+			m.Score = 1 - m.Distance/float64(longer)
+		}
+		if m.Score > 0 {
+			matches = append(matches, m)
+		}
+	}
+	l.mu.RUnlock()
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Headword < matches[j].Headword
+	})
+	if n > 0 && len(matches) > n {
+		matches = matches[:n]
+	}
+	return matches
+}
@@ -0,0 +1,53 @@
+package translit
+
+import "testing"
+
+func TestLexiconQuery(t *testing.T) {
+	lex := NewLexicon(V3())
+	lex.Add("ދިވެހި", "ދަރިވަރު", "މާލެ", "މުޙައްމަދު", "ޝަރުޠު", "ބަސް")
+	if lex.Len() != 6 {
+		t.Fatalf("Len() = %d, want 6", lex.Len())
+	}
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"divehi", "ދިވެހި"},
+		{"diveyhi", "ދިވެހި"},
+		{"male", "މާލެ"},
+		{"Mohamed", "މުޙައްމަދު"},
+		{"muhammadu", "މުޙައްމަދު"},
+		{"sharuthu", "ޝަރުޠު"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			matches := lex.Query(tt.query, 3)
+			if len(matches) == 0 {
+				t.Fatal("no matches")
+			}
+			if matches[0].Headword != tt.expected {
+				t.Errorf("best match %q (%s, %.2f), want %q", matches[0].Headword, matches[0].Latin, matches[0].Score, tt.expected)
+			}
+			for i := 1; i < len(matches); i++ {
+				if matches[i].Score > matches[i-1].Score {
+					t.Errorf("matches not ranked: %v", matches)
+				}
+			}
+		})
+	}
+}
+
+func TestLexiconQueryExact(t *testing.T) {
+	lex := NewLexicon(V4())
+	lex.Add("ބަސް", "ގަސް")
+
+	matches := lex.Query("bas", 0)
+	if len(matches) != 2 {
+		t.Fatalf("got %d matches, want 2", len(matches))
+	}
+	if m := matches[0]; m.Headword != "ބަސް" || m.Latin != "bas" || m.Distance != 0 || m.Score != 1 {
+		t.Errorf("got %+v, want exact match for ބަސް", m)
+	}
+}
@@ -0,0 +1,151 @@
+package translit
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxNameTokens bounds the tokens of either name that MatchName pairs up.
+const maxNameTokens = 12
+
+// NamePair is a token of a Thaana name and the Latin token it was paired with.
+type NamePair struct {
+	Thaana string
+	Latin  string
+	Score  float64 // token similarity in [0, 1]
+}
+
+// NameMatch is the result of MatchName.
+type NameMatch struct {
+	Score float64    // overall similarity in [0, 1]
+	Pairs []NamePair // best pairing, in Thaana token order
+}
+
+// MatchName scores how well the Latin spelling latin fits the Thaana name
+// thaana, for KYC checks and record linkage ("މުޙައްމަދު" against "Mohamed",
+// "Mohammed" or "Muhammadhu").
+//
+// Each Thaana token is romanized by translit3 with and without
+// NormalizeArabic, plus variants without the final "u" of Arabic names and
+// with a final "iy" (ތް) read as "t". Tokens are compared on their Fold keys
+// with WeightedDistance, so vowel length and Arabic-letter spellings cost
+// little. The tokens are then paired one to one, in any order, to maximize
+// the total similarity; the score is that total divided by the token count
+// of the longer name, so missing or extra names lower it.
+func MatchName(thaana, latin string) NameMatch {
+	tTokens := nameTokens(thaana)
+	lTokens := nameTokens(latin)
+	if len(tTokens) == 0 || len(lTokens) == 0 {
+		return NameMatch{}
+	}
+
+	sims := make([][]float64, len(tTokens))
+	for i, tt := range tTokens {
+		keys := nameKeys(tt)
+		sims[i] = make([]float64, len(lTokens))
+		for j, lt := range lTokens {
+			sims[i][j] = bestSimilarity(keys, Fold(lt))
+		}
+	}
+
+	total, pairing := bestPairing(sims)
+	m := NameMatch{Score: total / float64(max(len(tTokens), len(lTokens)))}
+	for i, j := range pairing {
+		if j >= 0 {
+			m.Pairs = append(m.Pairs, NamePair{Thaana: tTokens[i], Latin: lTokens[j], Score: sims[i][j]})
+		}
+	}
+	return m
+}
+
+// nameTokens splits a name on spaces and punctuation.
+func nameTokens(s string) []string {
+	tokens := strings.FieldsFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r) && r != '\''
+	})
+	if len(tokens) > maxNameTokens {
+		tokens = tokens[:maxNameTokens]
+	}
+	return tokens
+}
+
+// nameKeys returns the folded romanizations a Thaana name token may be
+// spelled as.
+func nameKeys(token string) []string {
+	var keys []string
+	add := func(k string) {
+		for _, have := range keys {
+			if have == k {
+				return
+			}
+		}
+		keys = append(keys, k)
+	}
+	for _, lat := range []string{
+		V3().Transliterate(token),
+		V3().TransliterateWithOptions(token, Options{NormalizeArabic: true}),
+	} {
+		add(foldLatin(lat))
+		if base, ok := strings.CutSuffix(lat, "iy"); ok && base != "" {
+			add(foldLatin(base + "t"))
+		}
+		if n := len(lat); n > 2 && lat[n-1] == 'u' && !isVowel(rune(lat[n-2])) {
+			add(foldLatin(lat[:n-1]))
+		}
+	}
+	return keys
+}
+
+// bestSimilarity returns the highest similarity between key and any of keys.
+func bestSimilarity(keys []string, key string) float64 {
+	best := 0.0
+	for _, k := range keys {
+		longer := max(utf8.RuneCountInString(k), utf8.RuneCountInString(key))
+		if longer == 0 {
+			continue
+		}
+		if s := 1 - WeightedDistance(k, key)/float64(longer); s > best {
+			best = s
+		}
+	}
+	return best
+}
+
+// bestPairing assigns each row of sims at most one distinct column so that
+// the summed similarity is maximal. pairing[i] is the column of row i, or -1.
+func bestPairing(sims [][]float64) (total float64, pairing []int) {
+	rows, cols := len(sims), len(sims[0])
+	size := 1 << cols
+
+	// best[i][mask] is the maximal total of rows i.. using columns not in mask.
+	best := make([][]float64, rows+1)
+	for i := range best {
+		best[i] = make([]float64, size)
+	}
+	for i := rows - 1; i >= 0; i-- {
+		for mask := 0; mask < size; mask++ {
+			v := best[i+1][mask]
+			for j := 0; j < cols; j++ {
+				if mask&(1<<j) == 0 && sims[i][j] > 0 {
+					v = max(v, sims[i][j]+best[i+1][mask|1<<j])
+				}
+			}
+			best[i][mask] = v
+		}
+	}
+
+	pairing = make([]int, rows)
+	mask := 0
+	for i := 0; i < rows; i++ {
+		pairing[i] = -1
+		for j := 0; j < cols; j++ {
+			if mask&(1<<j) == 0 && sims[i][j] > 0 && sims[i][j]+best[i+1][mask|1<<j] == best[i][mask] {
+				pairing[i] = j
+				mask |= 1 << j
+				break
+			}
+		}
+	}
+	return best[0][0], pairing
+}
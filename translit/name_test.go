@@ -0,0 +1,56 @@
+package translit
+
+import "testing"
+
+func TestMatchName(t *testing.T) {
+	tests := []struct {
+		thaana string
+		latin  string
+		min    float64
+		max    float64
+	}{
+		{"މުޙައްމަދު", "Muhammadhu", 1, 1},
+		{"މުޙައްމަދު", "Mohamed", 0.8, 0.9},
+		{"މުޙައްމަދު", "Mohammed", 0.8, 0.9},
+		{"ފާތިމަތު ޢަލީ", "Ali Fathimath", 1, 1},
+		{"އިބްރާހީމް ރަޝީދު", "IBRAHIM RASHEED", 1, 1},
+		{"ޢާއިޝަތު ޢިއްޒަތު", "Aishath Izzath", 1, 1},
+		{"އިބްރާހީމް ރަޝީދު", "Ibrahim", 0.5, 0.5},
+		{"މުޙައްމަދު", "Ahmed", 0, 0.6},
+		{"މުޙައްމަދު", "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.thaana+"/"+tt.latin, func(t *testing.T) {
+			m := MatchName(tt.thaana, tt.latin)
+			if m.Score < tt.min || m.Score > tt.max {
+				t.Errorf("score %.3f, want in [%.2f, %.2f]", m.Score, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+// A different name ranks below a variant spelling of the same one.
+func TestMatchNameRanking(t *testing.T) {
+	variant := MatchName("މުޙައްމަދު", "Mohamed").Score
+	other := MatchName("މުޙައްމަދު", "Ahmed").Score
+	if other >= variant {
+		t.Errorf("Ahmed scores %.3f, not below Mohamed at %.3f", other, variant)
+	}
+}
+
+func TestMatchNamePairs(t *testing.T) {
+	m := MatchName("ފާތިމަތު ޢަލީ ރަޝީދު", "Rasheed Fathmath")
+	want := []NamePair{
+		{Thaana: "ފާތިމަތު", Latin: "Fathmath"},
+		{Thaana: "ރަޝީދު", Latin: "Rasheed"},
+	}
+	if len(m.Pairs) != len(want) {
+		t.Fatalf("pairs = %+v, want %+v", m.Pairs, want)
+	}
+	for i, p := range m.Pairs {
+		if p.Thaana != want[i].Thaana || p.Latin != want[i].Latin {
+			t.Errorf("pair %d = %+v, want %+v", i, p, want[i])
+		}
+	}
+}
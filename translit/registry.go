@@ -0,0 +1,85 @@
+package translit
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Stable IDs of the built-in engines. IDs follow the BCP-47 transform style
+// "<source>-<target>/<variant>".
+const (
+	IDOriginal = "dv-Thaa-Latn/original" // translit1
+	IDMap      = "dv-Thaa-Latn/map"      // translit2
+	IDQawaaidu = "dv-Thaa-Latn/qawaaidu" // translit3
+	IDFast     = "dv-Thaa-Latn/fast"     // translit4
+	IDALALC    = "dv-Thaa-Latn/alalc"    // translit3, ALA-LC cataloguing
+	IDIPA      = "dv-Thaa-fonipa/broad"  // translit3, broad IPA
+	IDSouthern = "dv-Thaa-Latn/southern" // translit3, Addu and Fuvahmulah dialect
+	IDReverse  = "dv-Latn-Thaa/qawaaidu" // Latin → Thaana
+
+	IDDhivesAkuru       = "dv-Diak-Latn/qawaaidu" // Dhives Akuru → Latin
+	IDDhivesAkuruThaana = "dv-Diak-Thaa/modern"   // Dhives Akuru → Thaana
+
+	// Default is the engine used when the caller does not choose one.
+	Default = IDFast
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Engine)
+)
+
+func init() {
+	Register(IDOriginal, V1())
+	Register(IDMap, V2())
+	Register(IDQawaaidu, V3())
+	Register(IDFast, V4())
+	Register(IDALALC, ALALC())
+	Register(IDIPA, IPA())
+	Register(IDSouthern, Southern())
+	Register(IDReverse, Reverse())
+	Register(IDDhivesAkuru, DhivesAkuru())
+	Register(IDDhivesAkuruThaana, DhivesAkuruThaana())
+}
+
+// Register makes an engine available under the given ID. Like database/sql
+// drivers, third-party engines typically call it from an init function.
+// Register panics if e is nil or if id is empty or already registered.
+func Register(id string, e Engine) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if e == nil {
+		panic("translit: Register engine is nil")
+	}
+	if id == "" {
+		panic("translit: Register with empty id")
+	}
+	if _, dup := registry[id]; dup {
+		panic("translit: Register called twice for engine " + id)
+	}
+	registry[id] = e
+}
+
+// Lookup returns the engine registered under id.
+func Lookup(id string) (Engine, error) {
+	registryMu.RLock()
+	e, ok := registry[id]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("translit: unknown engine %q", id)
+	}
+	return e, nil
+}
+
+// Engines returns a sorted list of the IDs of the registered engines.
+func Engines() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	ids := make([]string, 0, len(registry))
+	for id := range registry {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,78 @@
+package translit
+
+import (
+	"slices"
+	"testing"
+)
+
+type bangEngine struct{}
+
+func (bangEngine) Name() string                                        { return "bang" }
+func (bangEngine) Version() string                                     { return "test" }
+func (bangEngine) Transliterate(input string) string                   { return input + "!" }
+func (bangEngine) TransliterateWithOptions(s string, _ Options) string { return s + "!" }
+
+func TestBuiltinEngines(t *testing.T) {
+	tests := []struct {
+		id   string
+		name string
+	}{
+		{IDOriginal, "translit1"},
+		{IDMap, "translit2"},
+		{IDQawaaidu, "translit3"},
+		{IDFast, "translit4"},
+		{IDALALC, "translit3-alalc"},
+		{IDIPA, "translit3-ipa"},
+		{IDSouthern, "translit3-southern"},
+		{IDReverse, "reverse"},
+		{IDDhivesAkuru, "dhivesakuru"},
+		{IDDhivesAkuruThaana, "dhivesakuru-thaana"},
+		{Default, "translit4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			e, err := Lookup(tt.id)
+			if err != nil {
+				t.Fatalf("Lookup(%q): %v", tt.id, err)
+			}
+			if got := e.Name(); got != tt.name {
+				t.Errorf("Lookup(%q).Name() = %q, want %q", tt.id, got, tt.name)
+			}
+		})
+	}
+}
+
+func TestLookupUnknown(t *testing.T) {
+	if _, err := Lookup("dv-Thaa-Latn/nope"); err == nil {
+		t.Error("Lookup of unknown engine succeeded, want error")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	const id = "x-test/bang"
+	Register(id, bangEngine{})
+	defer func() {
+		registryMu.Lock()
+		delete(registry, id)
+		registryMu.Unlock()
+	}()
+
+	if !slices.Contains(Engines(), id) {
+		t.Errorf("Engines() = %v, missing %q", Engines(), id)
+	}
+	e, err := Lookup(id)
+	if err != nil {
+		t.Fatalf("Lookup(%q): %v", id, err)
+	}
+	if got := e.Transliterate("a"); got != "a!" {
+		t.Errorf("Transliterate = %q, want %q", got, "a!")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("duplicate Register did not panic")
+		}
+	}()
+	Register(id, bangEngine{})
+}
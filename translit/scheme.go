@@ -0,0 +1,168 @@
+package translit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// Thaana code point ranges used to validate scheme entries.
+const (
+	akuruFirst   rune = 'ހ'
+	akuruLast    rune = 'ޥ'
+	filiFirst    rune = 'ަ'
+	filiLast     rune = 'ޯ'
+	thaanaFirst  rune = 'ހ'
+	thaanaLast   rune = '޿'
+	nishaanFirst rune = '؀'
+	nishaanLast  rune = 'ۿ'
+	alifu        rune = 'އ'
+	naa          rune = 'ޱ' // dialect akuru, outside akuruFirst..akuruLast
+)
+
+// scheme is the JSON form of a romanization scheme. Keys are single Thaana
+// (or, for nishaan, Arabic) characters, except for names, which are words.
+type scheme struct {
+	Consonants       map[string]string `json:"consonants"`
+	NormalizedArabic map[string]string `json:"normalized_arabic"`
+	Fili             map[string]string `json:"fili"`
+	SukunOverrides   map[string]string `json:"sukun_overrides"`
+	LetterNames      map[string]string `json:"letter_names"`
+	Nishaan          map[string]string `json:"nishaan"`
+	Names            map[string]string `json:"names"`
+}
+
+// LoadScheme reads a romanization scheme from a JSON file. See ParseScheme.
+func LoadScheme(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg, err := ParseScheme(data)
+	if err != nil {
+		return Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// ParseScheme decodes and validates a JSON romanization scheme, e.g.
+//
+//	{
+//	  "consonants":        {"ދ": "d", "ތ": "t"},
+//	  "normalized_arabic": {"ޝ": "sh"},
+//	  "fili":              {"ޯ": "o"},
+//	  "sukun_overrides":   {"ތ": "t"},
+//	  "letter_names":      {"މ": "meem"},
+//	  "nishaan":           {"؟": "?"},
+//	  "names":             {"ޝިފާ": "Shifa"}
+//	}
+//
+// Every section is optional and overrides the matching translit3 table;
+// unknown sections are an error. Consonant, sukun-override and letter-name
+// keys must be akuru (U+0780–U+07A5, or NAA U+07B1), fili keys fili
+// (U+07A6–U+07AF) and nishaan keys Arabic (U+0600–U+06FF) characters, and
+// names keys whole Thaana words, which extend the person-name lexicon of
+// Options.PersonNames. Latin values may not contain Thaana, and only Alifu
+// may map to an empty consonant. Pass the result to New.
+func ParseScheme(data []byte) (Config, error) {
+	var s scheme
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&s); err != nil {
+		return Config{}, fmt.Errorf("translit: scheme: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return Config{}, fmt.Errorf("translit: scheme: data after the top-level object")
+	}
+
+	var cfg Config
+	var err error
+	if cfg.Consonants, err = schemeTable("consonants", s.Consonants, akuruFirst, akuruLast, true); err != nil {
+		return Config{}, err
+	}
+	if cfg.NormalizedConsonants, err = schemeTable("normalized_arabic", s.NormalizedArabic, akuruFirst, akuruLast, true); err != nil {
+		return Config{}, err
+	}
+	if cfg.Vowels, err = schemeTable("fili", s.Fili, filiFirst, filiLast, true); err != nil {
+		return Config{}, err
+	}
+	if cfg.SukunOverrides, err = schemeTable("sukun_overrides", s.SukunOverrides, akuruFirst, akuruLast, false); err != nil {
+		return Config{}, err
+	}
+	if cfg.AkuruNames, err = schemeTable("letter_names", s.LetterNames, akuruFirst, akuruLast, true); err != nil {
+		return Config{}, err
+	}
+	if len(s.Nishaan) > 0 {
+		cfg.Nishaan = make(map[rune]rune, len(s.Nishaan))
+		for k, v := range s.Nishaan {
+			r, err := schemeKey("nishaan", k, nishaanFirst, nishaanLast)
+			if err != nil {
+				return Config{}, err
+			}
+			lat, size := utf8.DecodeRuneInString(v)
+			if size == 0 || size != len(v) || isThaana(lat) {
+				return Config{}, fmt.Errorf("translit: scheme: nishaan %q: value %q must be a single non-Thaana character", k, v)
+			}
+			cfg.Nishaan[r] = lat
+		}
+	}
+	if len(s.Names) > 0 {
+		cfg.Names = make(map[string]string, len(s.Names))
+		for k, v := range s.Names {
+			if k == "" || strings.IndexFunc(k, func(r rune) bool { return !isThaana(r) }) >= 0 {
+				return Config{}, fmt.Errorf("translit: scheme: names: key %q is not a Thaana word", k)
+			}
+			if v == "" || strings.IndexFunc(v, isThaana) >= 0 {
+				return Config{}, fmt.Errorf("translit: scheme: names %q: value %q must be non-empty Latin", k, v)
+			}
+			cfg.Names[k] = v
+		}
+	}
+	return cfg, nil
+}
+
+// schemeTable validates one string table of a scheme. If nonEmpty is set,
+// values other than Alifu's must not be empty.
+func schemeTable(section string, m map[string]string, first, last rune, nonEmpty bool) (map[rune]string, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	t := make(map[rune]string, len(m))
+	for k, v := range m {
+		r, err := schemeKey(section, k, first, last)
+		if err != nil {
+			return nil, err
+		}
+		if nonEmpty && v == "" && r != alifu {
+			return nil, fmt.Errorf("translit: scheme: %s %q: empty value", section, k)
+		}
+		for _, c := range v {
+			if isThaana(c) {
+				return nil, fmt.Errorf("translit: scheme: %s %q: value %q contains Thaana", section, k, v)
+			}
+		}
+		t[r] = v
+	}
+	return t, nil
+}
+
+// schemeKey checks that k is a single character in [first, last]. NAA is
+// accepted wherever akuru are.
+func schemeKey(section, k string, first, last rune) (rune, error) {
+	r, size := utf8.DecodeRuneInString(k)
+	if r == naa && size == len(k) && first == akuruFirst {
+		return r, nil
+	}
+	if size == 0 || size != len(k) || r < first || r > last {
+		return 0, fmt.Errorf("translit: scheme: %s: key %q is not a single character in U+%04X–U+%04X", section, k, first, last)
+	}
+	return r, nil
+}
+
+func isThaana(r rune) bool {
+	return r >= thaanaFirst && r <= thaanaLast
+}
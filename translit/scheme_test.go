@@ -0,0 +1,97 @@
+package translit
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadScheme(t *testing.T) {
+	cfg, err := LoadScheme(filepath.Join("..", "testdata", "schemes", "house_style.json"))
+	if err != nil {
+		t.Fatalf("LoadScheme: %v", err)
+	}
+	e := New(cfg)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ދިވެހި", "divehi"},
+		{"ރޯނު", "ronu"},
+		{"ފޭރު", "feru"},
+		{"ބަތް", "bat"},
+		{"ކީއްވެ؟", "keevve?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := e.Transliterate(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseSchemeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"Syntax", `{"consonants": `},
+		{"UnknownSection", `{"consonnants": {"ދ": "d"}}`},
+		{"TrailingData", `{"consonants": {"ދ": "d"}} {}`},
+		{"LatinKey", `{"consonants": {"d": "d"}}`},
+		{"MultiRuneKey", `{"consonants": {"ދި": "d"}}`},
+		{"FiliAsConsonant", `{"consonants": {"ި": "i"}}`},
+		{"ConsonantAsFili", `{"fili": {"ދ": "d"}}`},
+		{"EmptyConsonant", `{"consonants": {"ދ": ""}}`},
+		{"ThaanaValue", `{"consonants": {"ދ": "ދ"}}`},
+		{"NishaanKey", `{"nishaan": {"?": "?"}}`},
+		{"NishaanValue", `{"nishaan": {"؟": "??"}}`},
+		{"NamesLatinKey", `{"names": {"shifa": "Shifa"}}`},
+		{"NamesEmptyValue", `{"names": {"ޝިފާ": ""}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseScheme([]byte(tt.json)); err == nil {
+				t.Errorf("ParseScheme(%s) succeeded, want error", tt.json)
+			}
+		})
+	}
+}
+
+func TestParseSchemeAllowsEmpty(t *testing.T) {
+	cfg, err := ParseScheme([]byte(`{"consonants": {"އ": ""}, "sukun_overrides": {"ތ": ""}}`))
+	if err != nil {
+		t.Fatalf("ParseScheme: %v", err)
+	}
+	if result := New(cfg).Transliterate("ބަތް"); result != "ba" {
+		t.Errorf("got %q, want %q", result, "ba")
+	}
+}
+
+func TestParseSchemeNames(t *testing.T) {
+	cfg, err := ParseScheme([]byte(`{"names": {"ޝިފާ": "Shifa", "މުޙައްމަދު": "Muhammad"}}`))
+	if err != nil {
+		t.Fatalf("ParseScheme: %v", err)
+	}
+	e := New(cfg)
+	opts := Options{PersonNames: true}
+	if result := e.TransliterateWithOptions("ޝިފާ މުޙައްމަދު އަޙްމަދު", opts); result != "Shifa Muhammad Ahmed" {
+		t.Errorf("got %q", result)
+	}
+	if result := e.Transliterate("ޝިފާ"); result != "sh'ifaa" {
+		t.Errorf("got %q without PersonNames", result)
+	}
+}
+
+func TestParseSchemeNaa(t *testing.T) {
+	cfg, err := ParseScheme([]byte(`{"consonants": {"ޱ": "ṇ"}, "letter_names": {"ޱ": "nhaa"}}`))
+	if err != nil {
+		t.Fatalf("ParseScheme: %v", err)
+	}
+	if result := New(cfg).Transliterate("ކަޱު"); result != "kaṇu" {
+		t.Errorf("got %q, want %q", result, "kaṇu")
+	}
+}
@@ -0,0 +1,41 @@
+package translit
+
+import "testing"
+
+func TestSouthern(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"ބަތް", "bat"},
+		{"ކަށް", "kash"},
+		{"ކަޱު", "kanhu"},
+		{"ޜަމާން", "zamān"},
+		{"އައްޑޫ", "addū"},
+		{"ބޭބެ ރޯނު", "bēbe rōnu"},
+	}
+
+	e := Southern()
+	configured := New(SouthernConfig())
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := e.Transliterate(tt.input); result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+			if result := configured.Transliterate(tt.input); result != tt.expected {
+				t.Errorf("New(SouthernConfig()): got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSouthernConfigIsCopy(t *testing.T) {
+	cfg := SouthernConfig()
+	cfg.Consonants['ޱ'] = "ṇ"
+	if result := Southern().Transliterate("ޱަ"); result != "nha" {
+		t.Errorf("got %q, want %q", result, "nha")
+	}
+	if result := New(cfg).Transliterate("ޱަ"); result != "ṇa" {
+		t.Errorf("got %q, want %q", result, "ṇa")
+	}
+}
@@ -0,0 +1,29 @@
+package translit
+
+import translit3 "dhivehi-translit/internal/translit3"
+
+// SpellOut reads Thaana text letter by letter, for accessibility and for
+// dictating IDs: every akuru, fili and sukun becomes its name, separated by
+// spaces, and words are separated by ", " (ބަސް → "baa abafili seenu sukun").
+// Letter names come from one canonical table (meemu, seenu, gnaviyani…),
+// the same names translit2 and translit4 use for bare akuru.
+func SpellOut(input string) string {
+	return translit3.SpellOut(input)
+}
+
+// Speller returns an engine that transliterates like SpellOut, for use with
+// NewReader, NewWriter and the registry. Options are ignored.
+func Speller() Engine { return spellEngine{} }
+
+type spellEngine struct{}
+
+func (spellEngine) Name() string    { return "translit3-spell" }
+func (spellEngine) Version() string { return "v3" }
+
+func (spellEngine) Transliterate(input string) string {
+	return translit3.SpellOut(input)
+}
+
+func (spellEngine) TransliterateWithOptions(input string, _ Options) string {
+	return translit3.SpellOut(input)
+}
@@ -0,0 +1,49 @@
+package translit
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestSpellOut(t *testing.T) {
+	if result := SpellOut("ދިވެހި ބަސް"); result != "dhaalu ibifili vaavu ebefili haa ibifili, baa abafili seenu sukun" {
+		t.Errorf("got %q", result)
+	}
+}
+
+// Bare akuru are spelled out by translit2 and translit4; their names must
+// agree with SpellOut for every letter they know. ޜ (U+079C) is not
+// transliterated by either.
+func TestSpellOutMatchesEngines(t *testing.T) {
+	for r := rune(0x0780); r <= 0x07A5; r++ {
+		if r == 0x079C {
+			continue
+		}
+		letter := string(r)
+		want := SpellOut(letter)
+		for _, e := range []Engine{V2(), V4()} {
+			if got := e.Transliterate(letter); got != want {
+				t.Errorf("%s(%q) = %q, SpellOut = %q", e.Name(), letter, got, want)
+			}
+		}
+	}
+}
+
+func TestSpellerStream(t *testing.T) {
+	input := "ދިވެހި ބަސް\nބަސް"
+	got, err := io.ReadAll(NewReader(iotest.OneByteReader(strings.NewReader(input)), Speller(), Options{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := SpellOut(input); string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestSpellerName(t *testing.T) {
+	if e := Speller(); e.Name() != "translit3-spell" || e.Version() != "v3" {
+		t.Errorf("Speller() = %s %s, want translit3-spell v3", e.Name(), e.Version())
+	}
+}
@@ -0,0 +1,233 @@
+package translit
+
+import (
+	"errors"
+	"io"
+)
+
+var (
+	// ErrShortDst means that dst was too short to receive all transformed bytes.
+	ErrShortDst = errors.New("translit: short destination buffer")
+	// ErrShortSrc means that src had insufficient data to complete the
+	// transformation; the caller should retry with more input.
+	ErrShortSrc = errors.New("translit: short source buffer")
+)
+
+const defaultBufSize = 4096
+
+// Transformer adapts an Engine to chunked input. Its Transform method follows
+// the golang.org/x/text/transform.Transformer contract, so it can be used with
+// that package without this module depending on it.
+//
+// Engines peek up to three runes ahead (akuru + sukun + next akuru) and
+// translit2/translit4 also look back one rune, so a chunk is only cut after
+// whitespace, where every engine resets its word state. Input after the last
+// whitespace is left unconsumed and carried into the next call, as are the
+// words that may begin a place name of WithGazetteer running on into it.
+type Transformer struct {
+	engine Engine
+	opts   Options
+
+	out     string // output for src[:outN], kept after ErrShortDst
+	outN    int
+	outSent sentence // sent after out, taken over with it
+	sent    sentence // state of a Capitalized engine between chunks
+	scanned int      // leading bytes of src already known to hold no usable boundary
+}
+
+// NewTransformer returns a Transformer that runs e with opts.
+func NewTransformer(e Engine, opts Options) *Transformer {
+	return &Transformer{engine: e, opts: opts, sent: sentence{start: true}}
+}
+
+// phraseEngine is implemented by engines that replace phrases of several
+// words. holdBack returns the number of bytes at the end of input, a chunk
+// ending at a word boundary, that must wait for the next chunk because a
+// phrase may run on into it.
+type phraseEngine interface {
+	holdBack(input string) int
+}
+
+// holdBack returns what e holds back of input, 0 if e matches no phrases.
+func holdBack(e Engine, input string) int {
+	if pe, ok := e.(phraseEngine); ok {
+		return pe.holdBack(input)
+	}
+	return 0
+}
+
+// Transform transliterates src up to its last word boundary, less any words
+// the engine holds back (all of src if atEOF), into dst. It returns
+// ErrShortSrc if input was left unconsumed and ErrShortDst, consuming
+// nothing, if the output does not fit in dst. The output is kept until a
+// later call with a larger dst takes it, so a retry does not run the engine
+// again.
+func (t *Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	n := t.outN
+	if n == 0 || n > len(src) {
+		n = len(src)
+		if !atEOF {
+			n = lastBoundary(src, t.scanned)
+			n -= holdBack(t.engine, string(src[:n]))
+			if n == 0 {
+				t.scanned = len(src)
+				return 0, 0, ErrShortSrc
+			}
+		}
+		t.outN = n
+		if se, ok := t.engine.(sentenceEngine); ok {
+			t.out, t.outSent = se.transliterateFrom(string(src[:n]), t.opts, t.sent, atEOF)
+		} else {
+			t.out, t.outSent = t.engine.TransliterateWithOptions(string(src[:n]), t.opts), t.sent
+		}
+	}
+	if len(t.out) > len(dst) {
+		return 0, 0, ErrShortDst
+	}
+	nDst = copy(dst, t.out)
+	t.sent = t.outSent
+	t.out, t.outN, t.scanned = "", 0, 0
+	if n < len(src) {
+		err = ErrShortSrc
+	}
+	return nDst, n, err
+}
+
+// Reset discards the output kept after ErrShortDst, the progress of the
+// boundary scan and the sentence state, for use with new input.
+func (t *Transformer) Reset() {
+	t.out, t.outN, t.scanned = "", 0, 0
+	t.sent = sentence{start: true}
+}
+
+// lastBoundary returns the offset just past the last space, tab or newline
+// in src[from:], or 0 if there is none.
+func lastBoundary(src []byte, from int) int {
+	for i := len(src) - 1; i >= from; i-- {
+		if c := src[i]; c == ' ' || c == '\n' || c == '\t' {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// Reader transliterates the text read from an underlying io.Reader. Unlike a
+// bufio.Scanner it has no line-length limit: the source buffer grows until it
+// holds a complete word.
+type Reader struct {
+	r   io.Reader
+	t   *Transformer
+	err error // sticky error from r, io.EOF at end of input
+
+	src        []byte
+	src0, src1 int
+	dst        []byte
+	dst0, dst1 int
+	done       bool
+}
+
+// NewReader returns a Reader that transliterates r with e and opts.
+func NewReader(r io.Reader, e Engine, opts Options) *Reader {
+	return &Reader{
+		r:   r,
+		t:   NewTransformer(e, opts),
+		src: make([]byte, defaultBufSize),
+		dst: make([]byte, defaultBufSize),
+	}
+}
+
+// Read implements io.Reader.
+func (r *Reader) Read(p []byte) (int, error) {
+	for {
+		// Copy out any transformed bytes.
+		if r.dst0 != r.dst1 {
+			n := copy(p, r.dst[r.dst0:r.dst1])
+			r.dst0 += n
+			return n, nil
+		}
+		if r.done {
+			return 0, r.err
+		}
+
+		// Transform the buffered input.
+		if r.src0 != r.src1 || r.err != nil {
+			atEOF := r.err != nil
+			nDst, nSrc, err := r.t.Transform(r.dst, r.src[r.src0:r.src1], atEOF)
+			r.src0 += nSrc
+			r.dst0, r.dst1 = 0, nDst
+			switch {
+			case err == ErrShortDst:
+				r.dst = make([]byte, 2*len(r.dst))
+				continue
+			case err == nil && atEOF:
+				r.done = true
+			}
+			if nDst > 0 || r.done {
+				continue
+			}
+		}
+
+		// Read more input, growing the buffer if a word fills it.
+		if r.src0 != 0 {
+			r.src1 = copy(r.src, r.src[r.src0:r.src1])
+			r.src0 = 0
+		}
+		if r.src1 == len(r.src) {
+			src := make([]byte, 2*len(r.src))
+			copy(src, r.src[:r.src1])
+			r.src = src
+		}
+		n, err := r.r.Read(r.src[r.src1:])
+		r.src1 += n
+		if err != nil {
+			r.err = err
+		}
+	}
+}
+
+// Writer transliterates the text written to it and writes the result to an
+// underlying io.Writer. Close must be called to flush the final word.
+type Writer struct {
+	w   io.Writer
+	t   *Transformer
+	src []byte
+	dst []byte
+}
+
+// NewWriter returns a Writer that transliterates into w with e and opts.
+func NewWriter(w io.Writer, e Engine, opts Options) *Writer {
+	return &Writer{
+		w:   w,
+		t:   NewTransformer(e, opts),
+		dst: make([]byte, defaultBufSize),
+	}
+}
+
+// Write implements io.Writer. Input after the last word boundary is buffered
+// until a later Write or Close.
+func (w *Writer) Write(p []byte) (int, error) {
+	w.src = append(w.src, p...)
+	return len(p), w.flush(false)
+}
+
+// Close flushes any buffered input. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	return w.flush(true)
+}
+
+func (w *Writer) flush(atEOF bool) error {
+	for {
+		nDst, nSrc, err := w.t.Transform(w.dst, w.src, atEOF)
+		if err == ErrShortDst {
+			w.dst = make([]byte, 2*len(w.dst))
+			continue
+		}
+		w.src = w.src[:copy(w.src, w.src[nSrc:])]
+		if nDst > 0 {
+			if _, werr := w.w.Write(w.dst[:nDst]); werr != nil {
+				return werr
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,153 @@
+package translit
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+const streamInput = "ވިސްނުމެއް ނެތި ކޮށްފި ކަމަކުން އެންމެ ފަހަރަކު ދޭހުގައި ގިސްލަމުން ހިތި ކަރުނަ އޮއްސަން ޖެހި ދެޔޭ ޢުމުރަށް މުޅީން\nބައްބަ ކަނޑި ޢަމަލް"
+
+func TestTransform(t *testing.T) {
+	tr := NewTransformer(V4(), Options{})
+	dst := make([]byte, 64)
+
+	// No word boundary yet: nothing is consumed.
+	if nDst, nSrc, err := tr.Transform(dst, []byte("ބައްބަ"), false); nDst != 0 || nSrc != 0 || err != ErrShortSrc {
+		t.Errorf("Transform(no boundary) = %d, %d, %v; want 0, 0, ErrShortSrc", nDst, nSrc, err)
+	}
+
+	// Cut after the last space; the rest is carried over.
+	src := []byte("ބައްބަ ކަނޑި")
+	nDst, nSrc, err := tr.Transform(dst, src, false)
+	if err != ErrShortSrc || string(dst[:nDst]) != "babba " || nSrc != len("ބައްބަ ") {
+		t.Errorf("Transform = %q, %d, %v; want %q, %d, ErrShortSrc", dst[:nDst], nSrc, err, "babba ", len("ބައްބަ "))
+	}
+
+	if _, _, err := tr.Transform(make([]byte, 2), src, true); err != ErrShortDst {
+		t.Errorf("Transform(short dst) err = %v, want ErrShortDst", err)
+	}
+}
+
+// countEngine counts the calls to the engine it wraps.
+type countEngine struct {
+	Engine
+	calls *int
+}
+
+func (e countEngine) TransliterateWithOptions(s string, opts Options) string {
+	*e.calls++
+	return e.Engine.TransliterateWithOptions(s, opts)
+}
+
+func TestTransformShortDstRetry(t *testing.T) {
+	calls := 0
+	tr := NewTransformer(countEngine{V4(), &calls}, Options{})
+	src := []byte("ބައްބަ ކަނޑި ")
+
+	if _, _, err := tr.Transform(make([]byte, 2), src, false); err != ErrShortDst {
+		t.Fatalf("Transform(short dst) err = %v, want ErrShortDst", err)
+	}
+	dst := make([]byte, 64)
+	nDst, nSrc, err := tr.Transform(dst, src, false)
+	if err != nil || nSrc != len(src) || string(dst[:nDst]) != "babba kan'di " {
+		t.Errorf("Transform = %q, %d, %v", dst[:nDst], nSrc, err)
+	}
+	if calls != 1 {
+		t.Errorf("engine ran %d times, want 1", calls)
+	}
+}
+
+func TestTransformScansOnce(t *testing.T) {
+	tr := NewTransformer(V4(), Options{})
+	src := []byte(strings.Repeat("ބަ", 100))
+	for n := 2; n <= len(src); n += 2 {
+		if _, _, err := tr.Transform(nil, src[:n], false); err != ErrShortSrc {
+			t.Fatalf("Transform(%d bytes) err = %v, want ErrShortSrc", n, err)
+		}
+		if tr.scanned != n {
+			t.Fatalf("scanned = %d after %d bytes, want %d", tr.scanned, n, n)
+		}
+	}
+	tr.Reset()
+	if tr.scanned != 0 {
+		t.Errorf("scanned = %d after Reset, want 0", tr.scanned)
+	}
+}
+
+func TestReader(t *testing.T) {
+	for _, e := range []Engine{V1(), V2(), V3(), V4()} {
+		t.Run(e.Name(), func(t *testing.T) {
+			want := e.Transliterate(streamInput)
+
+			// One byte at a time splits every multi-byte rune and
+			// every akuru + sukun + akuru look-ahead.
+			got, err := io.ReadAll(NewReader(iotest.OneByteReader(strings.NewReader(streamInput)), e, Options{}))
+			if err != nil {
+				t.Fatalf("ReadAll: %v", err)
+			}
+			if string(got) != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// Place names of several words are not cut apart, whether the input arrives
+// a byte at a time or in one read that ends inside a name.
+func TestReaderGazetteer(t *testing.T) {
+	e := WithGazetteer(V3(), nil)
+	tests := []struct {
+		input, want string
+	}{
+		{"އައްޑޫ ސިޓީ", "Addu City"},
+		{"މާލެ ސިޓީ", "Malé City"},
+		{"ބަސް އައްޑޫ ސިޓީ ބަސް", "bas Addu City bas"},
+		{"މާލެ ބަސް", "Malé bas"},
+	}
+	for _, tt := range tests {
+		for _, r := range []io.Reader{
+			iotest.OneByteReader(strings.NewReader(tt.input)),
+			strings.NewReader(tt.input),
+		} {
+			got, err := io.ReadAll(NewReader(r, e, Options{}))
+			if err != nil {
+				t.Fatalf("ReadAll: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("%q: got %q, want %q", tt.input, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestReaderLongWord(t *testing.T) {
+	// A single word far longer than the internal buffer.
+	input := strings.Repeat("ބަ", 100000)
+	got, err := io.ReadAll(NewReader(strings.NewReader(input), V4(), Options{}))
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != strings.Repeat("ba", 100000) {
+		t.Errorf("long word mismatch (len %d)", len(got))
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, V3(), Options{})
+	for i := 0; i < len(streamInput); i += 7 {
+		end := min(i+7, len(streamInput))
+		if _, err := w.Write([]byte(streamInput[i:end])); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if want := V3().Transliterate(streamInput); buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,31 @@
+package translit
+
+import translit4 "dhivehi-translit/internal/translit4"
+
+// Syllable is one syllable of a Thaana word: an akuru with its fili, closed
+// by any akuru written with sukun. A bare Noonu before another akuru
+// (ކަނޑި → ކަ + ނޑި) prenasalizes the next syllable. Runs of non-Thaana text
+// form a Syllable of their own with zero Akuru and Fili.
+type Syllable struct {
+	Text     string // the syllable's Thaana, a substring of the word
+	Start    int    // byte offset of Text in the word
+	Akuru    rune   // consonant carrying the fili (Alifu for vowel-initial syllables)
+	Prenasal bool   // Akuru is preceded by a bare Noonu
+	Fili     rune   // vowel sign; 0 for a bare akuru
+	Sukun    string // akuru closing the syllable, each written with sukun in Text
+	Latin    string // the part of the word's translit4 output produced by Text
+}
+
+// Syllables splits a Thaana word into syllables, classifying akuru, fili and
+// sukun as translit4 does. The Latin fields are cut from the word's
+// transliteration, so they concatenate to translit4's output:
+//
+//	Syllables("ބައްބަ") // [{ބައް … Latin: "bab"} {ބަ … Latin: "ba"}]
+func Syllables(word string) []Syllable {
+	syls := translit4.Syllables(word)
+	out := make([]Syllable, len(syls))
+	for i, s := range syls {
+		out[i] = Syllable(s)
+	}
+	return out
+}
@@ -0,0 +1,14 @@
+package translit
+
+import "testing"
+
+func TestSyllables(t *testing.T) {
+	syls := Syllables("ކަނޑި")
+	if len(syls) != 2 {
+		t.Fatalf("got %d syllables, want 2", len(syls))
+	}
+	want := Syllable{Text: "ނޑި", Start: 4, Akuru: 'ޑ', Prenasal: true, Fili: 'ި', Latin: "n'di"}
+	if syls[1] != want {
+		t.Errorf("got %+v, want %+v", syls[1], want)
+	}
+}
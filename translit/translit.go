@@ -0,0 +1,29 @@
+// Package translit is the public entry point for Dhivehi transliteration.
+//
+// Each of the internal engines (translit1–translit4) is exposed through the
+// common Engine interface, so callers can switch between translit3 for
+// Qawaaidu accuracy and translit4 for throughput without changing call sites.
+// Reverse provides the Latin → Thaana direction behind the same interface.
+package translit
+
+// Options configures transliteration features. Engines ignore options they do
+// not implement; see each engine's documentation for what it supports.
+type Options struct {
+	Gemination          bool // consonant + sukun + same consonant → doubled output
+	SuppressGlottalStop bool // suppress apostrophe between adjacent vowels
+	NormalizeArabic     bool // collapse Arabic-derived letters to standard Latin
+	NoAkuruNames        bool // bare akuru → Latin consonant instead of its letter name
+	PersonNames         bool // whole words in the person-name lexicon → conventional spelling ("Mohamed")
+}
+
+// Engine is a transliterator between Thaana and Latin script.
+type Engine interface {
+	// Name returns the engine's package name, e.g. "translit3".
+	Name() string
+	// Version returns the short engine version used by the CLI, e.g. "v3".
+	Version() string
+	// Transliterate converts text with the engine's default options.
+	Transliterate(input string) string
+	// TransliterateWithOptions converts text with the given options.
+	TransliterateWithOptions(input string, opts Options) string
+}
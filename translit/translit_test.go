@@ -0,0 +1,63 @@
+package translit
+
+import "testing"
+
+func TestEngines(t *testing.T) {
+	tests := []struct {
+		engine   Engine
+		name     string
+		version  string
+		input    string
+		expected string
+	}{
+		{V1(), "translit1", "v1", "ޝަރުޠު", "sharuthu"},
+		{V2(), "translit2", "v2", "ޝަރުޠު", "sh'arut'u"},
+		{V3(), "translit3", "v3", "ޝަރުޠު", "sh'arut'u"},
+		{V4(), "translit4", "v4", "ޝަރުޠު", "sh'arut'u"},
+		{ALALC(), "translit3-alalc", "v3", "ޝަރުޠު", "sharut̤u"},
+		{IPA(), "translit3-ipa", "v3", "ޝަރުޠު", "ʃarutˤu"},
+		{Reverse(), "reverse", "r1", "sh'arut'u", "ޝަރުޠު"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.engine.Name(); got != tt.name {
+				t.Errorf("Name() = %q, want %q", got, tt.name)
+			}
+			if got := tt.engine.Version(); got != tt.version {
+				t.Errorf("Version() = %q, want %q", got, tt.version)
+			}
+			if got := tt.engine.Transliterate(tt.input); got != tt.expected {
+				t.Errorf("Transliterate(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEngineOptions(t *testing.T) {
+	tests := []struct {
+		engine   Engine
+		input    string
+		opts     Options
+		expected string
+	}{
+		{V1(), "ބައެއް", Options{SuppressGlottalStop: true}, "baeh"},
+		{V2(), "ބައްބަ ބ", Options{Gemination: true, NoAkuruNames: true}, "babba b"},
+		{V3(), "ޝަރުޠު", Options{NormalizeArabic: true}, "sharuthu"},
+		{V3(), "ބައްބަ", Options{Gemination: true}, "babba"},
+		{V4(), "ޝަރުޠު", Options{NormalizeArabic: true}, "sharuthu"},
+		{V4(), "ބަބްބަ", Options{Gemination: true}, "babbba"},
+		{ALALC(), "ބަބްބަ", Options{Gemination: true}, "babbba"},
+		{IPA(), "ޝަރުޠު", Options{NormalizeArabic: true}, "ʃarutu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.engine.Name()+"/"+tt.input, func(t *testing.T) {
+			result := tt.engine.TransliterateWithOptions(tt.input, tt.opts)
+			if result != tt.expected {
+				t.Errorf("TransliterateWithOptions(%q, %+v) = %q, want %q",
+					tt.input, tt.opts, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package translit
+
+import "strings"
+
+// thaanaWord is a run of Thaana letters at input[start:end].
+type thaanaWord struct {
+	start, end int
+}
+
+// thaanaWords returns the Thaana words of s: maximal runs of runes in the
+// Thaana block, so punctuation, digits and Latin text end a word.
+func thaanaWords(s string) []thaanaWord {
+	var words []thaanaWord
+	in := false
+	for i, r := range s {
+		switch {
+		case isThaana(r) && !in:
+			words = append(words, thaanaWord{start: i})
+			in = true
+		case !isThaana(r) && in:
+			words[len(words)-1].end = i
+			in = false
+		}
+	}
+	if in {
+		words[len(words)-1].end = len(s)
+	}
+	return words
+}
+
+// replaceWords transliterates input with translate, except for whole Thaana
+// words, or phrases of up to maxWords words separated by single spaces, for
+// which lookup returns a replacement. The longest phrase wins. A phrase
+// followed by a full stop is first looked up with it, so that entries for
+// abbreviations (ގދ.) match only where abbreviated. Text between
+// replacements is passed to translate in one piece; engines reset their
+// state at word boundaries, so the result is what translate would give with
+// the replacements made in its output.
+func replaceWords(input string, maxWords int, lookup func(string) (string, bool), translate func(string) string) string {
+	words := thaanaWords(input)
+	if len(words) == 0 || maxWords == 0 {
+		return translate(input)
+	}
+
+	var b strings.Builder
+	b.Grow(len(input) * 2)
+	prev := 0
+	for i := 0; i < len(words); i++ {
+		n, end, latin := matchPhrase(input, words[i:], maxWords, lookup)
+		if n == 0 {
+			continue
+		}
+		if words[i].start > prev {
+			b.WriteString(translate(input[prev:words[i].start]))
+		}
+		b.WriteString(latin)
+		prev = end
+		i += n - 1
+	}
+	if prev == 0 {
+		return translate(input)
+	}
+	if prev < len(input) {
+		b.WriteString(translate(input[prev:]))
+	}
+	return b.String()
+}
+
+// matchPhrase returns the number of words in the longest phrase at the start
+// of words for which lookup has a replacement, the end of the phrase in input
+// (past its full stop if that was matched too) and the replacement. n is 0
+// if there is no match.
+func matchPhrase(input string, words []thaanaWord, maxWords int, lookup func(string) (string, bool)) (n, end int, latin string) {
+	for n := min(maxWords, len(words)); n > 0; n-- {
+		if !phrase(input, words[:n]) {
+			continue
+		}
+		end := words[n-1].end
+		if strings.HasPrefix(input[end:], ".") {
+			if latin, ok := lookup(input[words[0].start : end+1]); ok {
+				return n, end + 1, latin
+			}
+		}
+		if latin, ok := lookup(input[words[0].start:end]); ok {
+			return n, end, latin
+		}
+	}
+	return 0, 0, ""
+}
+
+// heldWords returns the number of bytes at the end of input, a chunk of a
+// longer text ending at a word boundary, that replaceWords cannot handle
+// until more text is known: from the first word at which a phrase could run
+// on into the next chunk. The cut is moved back to whitespace that no
+// matched phrase spans, as the engines need.
+func heldWords(input string, maxWords int, lookup func(string) (string, bool)) int {
+	words := thaanaWords(input)
+	if len(words) == 0 || maxWords < 2 {
+		return 0
+	}
+	open := input[words[len(words)-1].end:] == " "
+
+	// starts holds the words at which the scan began a phrase or word.
+	var starts []int
+	for i := 0; i < len(words); i++ {
+		if open && len(words)-i < maxWords && phrase(input, words[i:]) {
+			starts = append(starts, i)
+			for j := len(starts) - 1; j >= 0; j-- {
+				from := 0
+				if k := starts[j]; k > 0 {
+					from = words[k-1].end
+				}
+				if ws := strings.LastIndexAny(input[from:words[starts[j]].start], " \n\t"); ws >= 0 {
+					return len(input) - (from + ws + 1)
+				}
+			}
+			return len(input)
+		}
+		starts = append(starts, i)
+		if n, _, _ := matchPhrase(input, words[i:], maxWords, lookup); n > 1 {
+			i += n - 1
+		}
+	}
+	return 0
+}
+
+// phrase reports whether words are separated by single spaces.
+func phrase(s string, words []thaanaWord) bool {
+	for i := 1; i < len(words); i++ {
+		if s[words[i-1].end:words[i].start] != " " {
+			return false
+		}
+	}
+	return true
+}